@@ -0,0 +1,481 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo
+
+import (
+	"bufio"
+	"encoding/hex"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// auditArches links the AUDIT_ARCH values used by the audit subsystem with
+// the names of the archs (see include/uapi/linux/audit.h).
+var auditArches = map[uint32]string{
+	0xc000003e: "linux_amd64",
+	0x40000003: "linux_386",
+	0x40000028: "linux_arm",
+	0xc00000b7: "linux_arm64",
+	0xc00000f3: "linux_riscv64",
+}
+
+// auditArchNames links the arch names printed by ausearch -i with the names
+// of the archs.
+var auditArchNames = map[string]string{
+	"x86_64":  "linux_amd64",
+	"i386":    "linux_386",
+	"armeb":   "linux_arm",
+	"aarch64": "linux_arm64",
+	"riscv64": "linux_riscv64",
+}
+
+// x32SyscallBit is set in the numbers of the x32 syscalls of linux_amd64.
+const x32SyscallBit = 0x40000000
+
+// ArchFromAudit returns the name of the arch identified by the AUDIT_ARCH
+// value arch (e.g. "linux_amd64" for 0xc000003e).
+func ArchFromAudit(arch uint32) (string, error) {
+	name, ok := auditArches[arch]
+	if !ok {
+		return "", &UnknownArchError{Arch: "audit arch " + strconv.FormatUint(uint64(arch), 16)}
+	}
+	return name, nil
+}
+
+// An AuditEvent is a syscall event read from a Linux audit log. It joins
+// the SYSCALL record with the CWD, EXECVE, PATH and PROCTITLE records that
+// share its serial number.
+//
+// The embedded TraceRecord describes the call. The audit subsystem only
+// logs the first four arguments (a0 to a3), so the rest are missing.
+type AuditEvent struct {
+	TraceRecord
+
+	// Serial is the serial number of the event.
+	Serial uint64
+
+	// Arch is the name of the arch of the call (e.g. "linux_amd64").
+	Arch string
+
+	// Fields contains the fields of the SYSCALL record (e.g. "uid" or
+	// "comm"). Strings are decoded.
+	Fields map[string]string
+
+	// CWD is the working directory of the process.
+	CWD string
+
+	// Argv contains the arguments of execve.
+	Argv []string
+
+	// Paths contains the PATH records, sorted by item number.
+	Paths []AuditPath
+
+	// Proctitle is the command line of the process. Its arguments are
+	// separated by spaces.
+	Proctitle string
+}
+
+// An AuditPath is a PATH record of an audit event.
+type AuditPath struct {
+	// Item is the index of the path in the event.
+	Item int
+
+	// Name is the path as passed to the syscall.
+	Name string
+
+	// Nametype describes the use of the path (e.g. "NORMAL" or "CREATE").
+	Nametype string
+
+	// Fields contains all the fields of the record. Strings are decoded.
+	Fields map[string]string
+}
+
+var (
+	auditLineRE  = regexp.MustCompile(`^(?:node=\S+ )?type=(\S+) msg=audit\(([^)]*):(\d+)\)\s*:\s*(.*)$`)
+	auditEpochRE = regexp.MustCompile(`^(\d+)(?:\.(\d+))?$`)
+)
+
+// auditTimeLayout is the layout of the timestamps printed by ausearch -i.
+const auditTimeLayout = "01/02/2006 15:04:05.000"
+
+// An AuditReader reads the syscall events of a Linux audit log, as written
+// by auditd or printed by ausearch (with or without -i). The arch of each
+// event is resolved using the AUDIT_ARCH value of its SYSCALL record, so
+// the tables of the archs must be registered.
+//
+// The records of an event are joined until its EOE record is found. Logs
+// without EOE records, written by old kernels, are supported as long as the
+// records of different events are not interleaved. Events without a
+// SYSCALL record are ignored.
+type AuditReader struct {
+	s       *bufio.Scanner
+	line    int
+	eoe     bool
+	pending map[uint64]*AuditEvent
+	queue   []*AuditEvent
+}
+
+// NewAuditReader returns an AuditReader that reads from rd.
+func NewAuditReader(rd io.Reader) *AuditReader {
+	return &AuditReader{
+		s:       bufio.NewScanner(rd),
+		pending: map[uint64]*AuditEvent{},
+	}
+}
+
+// Read returns the next syscall record of the log. It implements
+// TraceReader. The returned record is embedded in an AuditEvent, use
+// ReadEvent to get the whole event.
+func (ar *AuditReader) Read() (*TraceRecord, error) {
+	ev, err := ar.ReadEvent()
+	if err != nil {
+		return nil, err
+	}
+	return &ev.TraceRecord, nil
+}
+
+// ReadEvent returns the next event of the log. It returns io.EOF when there
+// are no more events.
+func (ar *AuditReader) ReadEvent() (*AuditEvent, error) {
+	for len(ar.queue) == 0 {
+		if !ar.s.Scan() {
+			if err := ar.s.Err(); err != nil {
+				return nil, err
+			}
+			if len(ar.pending) == 0 {
+				return nil, io.EOF
+			}
+			ar.flush(nil)
+			break
+		}
+		ar.line++
+		if err := ar.parseLine(ar.s.Text()); err != nil {
+			return nil, err
+		}
+	}
+	ev := ar.queue[0]
+	ar.queue = ar.queue[1:]
+	return ev, nil
+}
+
+// ReadAll reads all the remaining events of the log.
+func (ar *AuditReader) ReadAll() ([]*AuditEvent, error) {
+	var evs []*AuditEvent
+	for {
+		ev, err := ar.ReadEvent()
+		if err == io.EOF {
+			return evs, nil
+		}
+		if err != nil {
+			return evs, err
+		}
+		evs = append(evs, ev)
+	}
+}
+
+func (ar *AuditReader) parseLine(line string) error {
+	// Enriched logs append the interpreted fields after a GS character.
+	if i := strings.IndexByte(line, 0x1d); i >= 0 {
+		line = line[:i]
+	}
+	text := strings.TrimSpace(line)
+	if text == "" || text == "----" || strings.HasPrefix(text, "time->") {
+		return nil
+	}
+	m := auditLineRE.FindStringSubmatch(text)
+	if m == nil {
+		return ar.syntaxError(line)
+	}
+	typ, body := m[1], m[4]
+	serial, err := strconv.ParseUint(m[3], 10, 64)
+	if err != nil {
+		return ar.syntaxError(line)
+	}
+	// The values of the lines printed by ausearch -i, which have a human
+	// readable timestamp, are interpreted.
+	var (
+		t           time.Duration
+		interpreted bool
+	)
+	if tm := auditEpochRE.FindStringSubmatch(m[2]); tm != nil {
+		t = parseSeconds(tm[1], tm[2])
+	} else {
+		lt, err := time.ParseInLocation(auditTimeLayout, m[2], time.Local)
+		if err != nil {
+			return ar.syntaxError(line)
+		}
+		t = time.Duration(lt.UnixNano())
+		interpreted = true
+	}
+	fields := parseAuditFields(typ, body, interpreted)
+
+	ev := ar.pending[serial]
+	switch typ {
+	case "SYSCALL":
+		if !ar.eoe {
+			ar.flush(func(s uint64) bool { return s != serial })
+		}
+		if ev == nil {
+			ev = &AuditEvent{Serial: serial}
+			ar.pending[serial] = ev
+		}
+		ev.Time = t
+		if err := ev.setSyscall(fields, interpreted); err != nil {
+			delete(ar.pending, serial)
+			return err
+		}
+	case "EOE":
+		ar.eoe = true
+		if ev != nil {
+			ev.Line = ar.line
+			ar.flush(func(s uint64) bool { return s == serial })
+		}
+		return nil
+	case "CWD", "EXECVE", "PATH", "PROCTITLE":
+		if ev == nil {
+			// Records that precede the SYSCALL record are kept until it is
+			// found.
+			ev = &AuditEvent{Serial: serial}
+			ar.pending[serial] = ev
+		}
+		ev.addRecord(typ, fields)
+	default:
+		return nil
+	}
+	ev.Line = ar.line
+	return nil
+}
+
+// flush queues the pending events whose serial number is selected by sel,
+// sorted by serial number. If sel is nil, all the pending events are
+// queued. Events without a SYSCALL record are discarded.
+func (ar *AuditReader) flush(sel func(serial uint64) bool) {
+	var serials []uint64
+	for s := range ar.pending {
+		if sel == nil || sel(s) {
+			serials = append(serials, s)
+		}
+	}
+	sort.Slice(serials, func(i, j int) bool { return serials[i] < serials[j] })
+	for _, s := range serials {
+		ev := ar.pending[s]
+		delete(ar.pending, s)
+		if ev.Fields == nil {
+			continue
+		}
+		sort.Slice(ev.Paths, func(i, j int) bool { return ev.Paths[i].Item < ev.Paths[j].Item })
+		ar.queue = append(ar.queue, ev)
+	}
+}
+
+func (ar *AuditReader) syntaxError(line string) error {
+	return &TraceSyntaxError{Format: "audit", Line: ar.line, Text: line}
+}
+
+// setSyscall fills ev with the fields of a SYSCALL record. If interpreted is
+// true, the values are the ones printed by ausearch -i.
+func (ev *AuditEvent) setSyscall(fields map[string]string, interpreted bool) error {
+	ev.Fields = fields
+	ev.Kind = TraceSyscall
+	ev.PID, _ = strconv.Atoi(fields["pid"])
+
+	arch, err := auditArch(fields["arch"])
+	if err != nil {
+		return err
+	}
+	r, err := NewArchResolver(arch)
+	if err != nil {
+		return err
+	}
+	ev.Arch = arch
+	ev.wordSize = WordSize(arch)
+
+	if n, err := strconv.ParseUint(fields["syscall"], 10, 32); err == nil {
+		if arch == "linux_amd64" {
+			n &^= x32SyscallBit
+		}
+		ev.Syscall = r.SyscallNOrUnknown(int(n))
+	} else {
+		ev.Syscall = resolveName(r, fields["syscall"])
+	}
+
+	for i := 0; i < 4; i++ {
+		text, ok := fields["a"+strconv.Itoa(i)]
+		if !ok {
+			break
+		}
+		if interpreted {
+			ev.Args = append(ev.Args, parseTraceValue(text))
+			continue
+		}
+		tv := TraceValue{Text: text}
+		if v, err := strconv.ParseUint(text, 16, 64); err == nil {
+			tv.Value, tv.Numeric = v, true
+		}
+		ev.Args = append(ev.Args, tv)
+	}
+
+	switch fields["success"] {
+	case "yes":
+		ev.Status = StatusSuccessful
+	case "no":
+		ev.Status = StatusFailed
+	default:
+		ev.Status = StatusUnavailable
+	}
+	if exit, ok := fields["exit"]; ok {
+		// ausearch -i prints errors as "ENOENT(No such file or directory)".
+		if i := strings.IndexByte(exit, '('); i > 0 && strings.HasPrefix(exit, "E") {
+			ev.Errno = exit[:i]
+			ev.Info = strings.TrimSuffix(exit[i+1:], ")")
+			exit = exit[:i]
+		}
+		ev.Ret = parseTraceValue(exit)
+	}
+	return nil
+}
+
+// auditArch returns the name of the arch of a SYSCALL record, which is
+// printed as an AUDIT_ARCH value or, by ausearch -i, as a name.
+func auditArch(s string) (string, error) {
+	if name, ok := auditArchNames[s]; ok {
+		return name, nil
+	}
+	arch, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return "", &UnknownArchError{Arch: "audit arch " + s}
+	}
+	return ArchFromAudit(uint32(arch))
+}
+
+// addRecord adds a record of type typ to ev.
+func (ev *AuditEvent) addRecord(typ string, fields map[string]string) {
+	switch typ {
+	case "CWD":
+		ev.CWD = fields["cwd"]
+	case "EXECVE":
+		argc, _ := strconv.Atoi(fields["argc"])
+		ev.Argv = make([]string, argc)
+		for i := range ev.Argv {
+			ev.Argv[i] = execveArg(fields, i)
+		}
+	case "PATH":
+		item, _ := strconv.Atoi(fields["item"])
+		ev.Paths = append(ev.Paths, AuditPath{
+			Item:     item,
+			Name:     fields["name"],
+			Nametype: fields["nametype"],
+			Fields:   fields,
+		})
+	case "PROCTITLE":
+		ev.Proctitle = strings.Replace(fields["proctitle"], "\x00", " ", -1)
+	}
+}
+
+// execveArg returns the argument i of an EXECVE record. Long arguments are
+// split in several fields (a1[0], a1[1], etc.).
+func execveArg(fields map[string]string, i int) string {
+	key := "a" + strconv.Itoa(i)
+	if arg, ok := fields[key]; ok {
+		return arg
+	}
+	var arg string
+	for j := 0; ; j++ {
+		part, ok := fields[key+"["+strconv.Itoa(j)+"]"]
+		if !ok {
+			return arg
+		}
+		arg += part
+	}
+}
+
+// auditStringFields contains the fields whose values are strings. They are
+// logged quoted or, if they contain special characters, hex encoded.
+var auditStringFields = map[string]bool{
+	"comm":      true,
+	"exe":       true,
+	"key":       true,
+	"cwd":       true,
+	"name":      true,
+	"proctitle": true,
+}
+
+// parseAuditFields parses the "key=value" fields of an audit record of type
+// typ and decodes their strings. If interpreted is true, the strings are
+// not encoded, as printed by ausearch -i.
+func parseAuditFields(typ, s string, interpreted bool) map[string]string {
+	fields := map[string]string{}
+	for len(s) > 0 {
+		s = strings.TrimLeft(s, " ")
+		i := strings.IndexByte(s, '=')
+		if i < 0 {
+			break
+		}
+		key := s[:i]
+		s = s[i+1:]
+		if interpreted && key == "proctitle" {
+			// It is the only field of the record and may contain spaces.
+			fields[key] = s
+			break
+		}
+		var val string
+		quoted := len(s) > 0 && (s[0] == '"' || s[0] == '\'')
+		if quoted {
+			end := strings.IndexByte(s[1:], s[0])
+			if end < 0 {
+				end = len(s) - 1
+			}
+			val, s = s[1:end+1], s[end+1:]
+			if len(s) > 0 {
+				s = s[1:]
+			}
+		} else {
+			end := strings.IndexByte(s, ' ')
+			if end < 0 {
+				end = len(s)
+			}
+			// Interpreted values such as "EACCES(Permission denied)"
+			// may contain spaces inside the parentheses.
+			if interpreted && strings.Contains(s[:end], "(") && !strings.Contains(s[:end], ")") {
+				if j := strings.IndexByte(s, ')'); j >= 0 {
+					end = j + 1
+				}
+			}
+			val, s = s[:end], s[end:]
+		}
+		if !quoted && !interpreted && isAuditString(typ, key) {
+			val = decodeAuditHex(val)
+		}
+		fields[key] = val
+	}
+	return fields
+}
+
+// isAuditString reports whether the field key of a record of type typ
+// contains a string. The arguments of EXECVE records (a0, a1[0], etc.) are
+// strings, while the ones of SYSCALL records are numbers.
+func isAuditString(typ, key string) bool {
+	if auditStringFields[key] {
+		return true
+	}
+	return typ == "EXECVE" && strings.HasPrefix(key, "a") && !strings.HasSuffix(key, "_len")
+}
+
+// decodeAuditHex decodes a hex encoded string. "(null)" is returned as an
+// empty string. If s is not hex encoded, it is returned unchanged.
+func decodeAuditHex(s string) string {
+	if s == "(null)" {
+		return ""
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return s
+	}
+	return string(b)
+}
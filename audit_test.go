@@ -0,0 +1,204 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jroimartin/syscallinfo"
+	_ "github.com/jroimartin/syscallinfo/linux_386"
+	_ "github.com/jroimartin/syscallinfo/linux_amd64"
+	_ "github.com/jroimartin/syscallinfo/linux_arm"
+)
+
+const auditLog = `type=SYSCALL msg=audit(1364481363.243:24287): arch=c000003e syscall=2 success=no exit=-13 a0=7fffd19c5592 a1=0 a2=7fffd19c4b50 a3=a items=1 ppid=2686 pid=3538 auid=1000 uid=1000 gid=1000 euid=1000 suid=1000 fsuid=1000 egid=1000 sgid=1000 fsgid=1000 tty=pts0 ses=1 comm="cat" exe="/bin/cat" key="sshd_config"
+type=CWD msg=audit(1364481363.243:24287):  cwd="/home/shadowman"
+type=PATH msg=audit(1364481363.243:24287): item=0 name="/etc/ssh/sshd_config" inode=409248 dev=fd:00 mode=0100600 ouid=0 ogid=0 rdev=00:00 nametype=NORMAL
+type=USER_LOGIN msg=audit(1364481364.000:24288): pid=1 uid=0 msg='op=login acct="root" res=success'
+type=SYSCALL msg=audit(1364481365.100:24289): arch=c000003e syscall=59 success=yes exit=0 a0=55d1 a1=55d2 a2=55d3 a3=0 items=2 ppid=1 pid=4000 comm="ls" exe="/usr/bin/ls" key=(null)
+type=EXECVE msg=audit(1364481365.100:24289): argc=3 a0="ls" a1="-l" a2=2F746D702F6D7920646972
+type=CWD msg=audit(1364481365.100:24289): cwd=2F686F6D652F6D7920757365722F
+type=PATH msg=audit(1364481365.100:24289): item=1 name="/lib64/ld-linux-x86-64.so.2" nametype=NORMAL
+type=PATH msg=audit(1364481365.100:24289): item=0 name="/usr/bin/ls" nametype=NORMAL
+type=PROCTITLE msg=audit(1364481365.100:24289): proctitle=6C73002D6C
+type=EOE msg=audit(1364481365.100:24289): 
+type=SYSCALL msg=audit(1364481366.000:24290): arch=40000003 syscall=5 success=yes exit=3 a0=bf8e2000 a1=8000 a2=0 a3=0 items=1 ppid=1 pid=4001 comm="cat" exe="/bin/cat" key=(null)
+type=EOE msg=audit(1364481366.000:24290): 
+type=SYSCALL msg=audit(1364481367.000:24291): arch=c000003e syscall=1073742344 success=yes exit=0 a0=1 a1=2 a2=3 a3=0 items=0 ppid=1 pid=4002 comm="x32" exe="/x32" key=(null)
+type=EOE msg=audit(1364481367.000:24291): 
+`
+
+var checksAudit = []struct {
+	serial    uint64
+	line      int
+	time      time.Duration
+	arch      string
+	num       int
+	name      string
+	args      []uint64
+	ret       uint64
+	status    syscallinfo.CallStatus
+	pid       int
+	cwd       string
+	argv      []string
+	paths     []string
+	proctitle string
+}{
+	{
+		24287, 3, 1364481363243 * time.Millisecond, "linux_amd64", 2, "open",
+		[]uint64{0x7fffd19c5592, 0, 0x7fffd19c4b50, 0xa}, ^uint64(12), syscallinfo.StatusFailed, 3538,
+		"/home/shadowman", nil, []string{"/etc/ssh/sshd_config"}, "",
+	},
+	{
+		24289, 11, 1364481365100 * time.Millisecond, "linux_amd64", 59, "execve",
+		[]uint64{0x55d1, 0x55d2, 0x55d3, 0}, 0, syscallinfo.StatusSuccessful, 4000,
+		"/home/my user/", []string{"ls", "-l", "/tmp/my dir"}, []string{"/usr/bin/ls", "/lib64/ld-linux-x86-64.so.2"}, "ls -l",
+	},
+	{
+		24290, 13, 1364481366 * time.Second, "linux_386", 5, "open",
+		[]uint64{0xbf8e2000, 0x8000, 0, 0}, 3, syscallinfo.StatusSuccessful, 4001,
+		"", nil, nil, "",
+	},
+	{
+		24291, 15, 1364481367 * time.Second, "linux_amd64", 520, "execve",
+		[]uint64{1, 2, 3, 0}, 0, syscallinfo.StatusSuccessful, 4002,
+		"", nil, nil, "",
+	},
+}
+
+func TestAuditReader(t *testing.T) {
+	evs, err := syscallinfo.NewAuditReader(strings.NewReader(auditLog)).ReadAll()
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	if len(evs) != len(checksAudit) {
+		t.Fatalf("wrong number of events (want=%v, get=%v)", len(checksAudit), len(evs))
+	}
+	for i, check := range checksAudit {
+		ev := evs[i]
+		if ev.Serial != check.serial || ev.Line != check.line || ev.Time != check.time || ev.Arch != check.arch || ev.PID != check.pid {
+			t.Errorf("wrong event (want=%v %v %v %v %v, get=%v %v %v %v %v)",
+				check.serial, check.line, check.time, check.arch, check.pid,
+				ev.Serial, ev.Line, ev.Time, ev.Arch, ev.PID)
+			continue
+		}
+		if ev.Syscall.Num != check.num || ev.Syscall.Name != check.name {
+			t.Errorf("%v: wrong syscall (want=%v %v, get=%v %v)", check.serial, check.num, check.name, ev.Syscall.Num, ev.Syscall.Name)
+		}
+		if ev.Status != check.status {
+			t.Errorf("%v: wrong status (want=%v, get=%v)", check.serial, check.status, ev.Status)
+		}
+		scc, err := ev.Call()
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		for j, arg := range check.args {
+			if ev.Args[j].Value != arg || !ev.Args[j].Numeric {
+				t.Errorf("%v: wrong arg %d (want=%#x, get=%#x)", check.serial, j, arg, ev.Args[j].Value)
+			}
+		}
+		if ev.Ret.Value != check.ret {
+			t.Errorf("%v: wrong ret (want=%#x, get=%#x)", check.serial, check.ret, ev.Ret.Value)
+		}
+		if scc.Failed() != (check.status == syscallinfo.StatusFailed) {
+			t.Errorf("%v: wrong failed (want=%v, get=%v)", check.serial, !scc.Failed(), scc.Failed())
+		}
+		if ev.CWD != check.cwd {
+			t.Errorf("%v: wrong cwd (want=%q, get=%q)", check.serial, check.cwd, ev.CWD)
+		}
+		if strings.Join(ev.Argv, "|") != strings.Join(check.argv, "|") {
+			t.Errorf("%v: wrong argv (want=%q, get=%q)", check.serial, check.argv, ev.Argv)
+		}
+		var paths []string
+		for _, p := range ev.Paths {
+			paths = append(paths, p.Name)
+		}
+		if strings.Join(paths, "|") != strings.Join(check.paths, "|") {
+			t.Errorf("%v: wrong paths (want=%q, get=%q)", check.serial, check.paths, paths)
+		}
+		if ev.Proctitle != check.proctitle {
+			t.Errorf("%v: wrong proctitle (want=%q, get=%q)", check.serial, check.proctitle, ev.Proctitle)
+		}
+	}
+	if get := evs[0].Fields["comm"]; get != "cat" {
+		t.Errorf("wrong comm (want=cat, get=%v)", get)
+	}
+	if get := evs[1].Fields["key"]; get != "" {
+		t.Errorf("wrong key (want=, get=%v)", get)
+	}
+}
+
+func TestAuditReader_interpreted(t *testing.T) {
+	const log = `----
+type=PROCTITLE msg=audit(03/28/2013 10:36:03.243:24287) : proctitle=cat /etc/ssh/sshd_config
+type=PATH msg=audit(03/28/2013 10:36:03.243:24287) : item=0 name=/etc/ssh/sshd_config inode=409248 nametype=NORMAL
+type=CWD msg=audit(03/28/2013 10:36:03.243:24287) :  cwd=/home/shadowman
+type=SYSCALL msg=audit(03/28/2013 10:36:03.243:24287) : arch=x86_64 syscall=open success=no exit=EACCES(Permission denied) a0=0x7fffd19c5592 a1=O_RDONLY a2=0x7fffd19c4b50 a3=0xa items=1 ppid=2686 pid=3538 comm=cat exe=/bin/cat key=sshd_config
+`
+	ev, err := syscallinfo.NewAuditReader(strings.NewReader(log)).ReadEvent()
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	want := time.Date(2013, 3, 28, 10, 36, 3, 243e6, time.Local)
+	if ev.Time != time.Duration(want.UnixNano()) {
+		t.Errorf("wrong time (want=%v, get=%v)", time.Duration(want.UnixNano()), ev.Time)
+	}
+	if ev.Syscall.Num != 2 || ev.Errno != "EACCES" || ev.Info != "Permission denied" {
+		t.Errorf("wrong call (want=2 EACCES, get=%v %v %v)", ev.Syscall.Num, ev.Errno, ev.Info)
+	}
+	if ev.Args[0].Value != 0x7fffd19c5592 || ev.Args[1].Value != 0 || !ev.Args[1].Numeric {
+		t.Errorf("wrong args (want=0x7fffd19c5592 0, get=%#x %#x)", ev.Args[0].Value, ev.Args[1].Value)
+	}
+	if ev.Proctitle != "cat /etc/ssh/sshd_config" || ev.CWD != "/home/shadowman" || len(ev.Paths) != 1 {
+		t.Errorf("wrong records (get=%q %q %v)", ev.Proctitle, ev.CWD, ev.Paths)
+	}
+}
+
+func TestAuditReader_wordSize(t *testing.T) {
+	const log = `type=SYSCALL msg=audit(1.0:1): arch=40000028 syscall=322 success=no exit=-2 a0=ffffff9c a1=be8f0e10 a2=20000 a3=0 pid=1
+type=EOE msg=audit(1.0:1): 
+`
+	ev, err := syscallinfo.NewAuditReader(strings.NewReader(log)).ReadEvent()
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	scc, err := ev.Call()
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	const want = "openat(0xffffff9c, 0xbe8f0e10, 0x00020000, 0x00000000) = 0xfffffffe"
+	if get := scc.String(); get != want {
+		t.Errorf("wrong call (want=%v, get=%v)", want, get)
+	}
+	if !scc.Failed() {
+		t.Errorf("wrong failed (want=true, get=false)")
+	}
+}
+
+func TestAuditReader_unknownArch(t *testing.T) {
+	const log = `type=SYSCALL msg=audit(1.0:1): arch=c0000015 syscall=3 success=yes exit=0 a0=0 a1=0 a2=0 a3=0 pid=1
+type=EOE msg=audit(1.0:1): 
+type=SYSCALL msg=audit(2.0:2): arch=c000003e syscall=0 success=yes exit=0 a0=0 a1=0 a2=0 a3=0 pid=1
+type=EOE msg=audit(2.0:2): 
+`
+	ar := syscallinfo.NewAuditReader(strings.NewReader(log))
+	_, err := ar.Read()
+	if aerr, ok := err.(*syscallinfo.UnknownArchError); !ok || aerr.Arch != "audit arch c0000015" {
+		t.Errorf("wrong error (want=unknown arch audit arch c0000015, get=%v)", err)
+	}
+	rec, err := ar.Read()
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	if rec.Syscall.Name != "read" {
+		t.Errorf("wrong syscall (want=read, get=%v)", rec.Syscall.Name)
+	}
+	if _, err := ar.Read(); err != io.EOF {
+		t.Errorf("wrong error (want=%v, get=%v)", io.EOF, err)
+	}
+}
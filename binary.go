@@ -0,0 +1,153 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo
+
+import "encoding/binary"
+
+// binaryMagic identifies the binary encoding of a syscall table and its
+// version.
+const binaryMagic = "SCT\x01"
+
+// MarshalBinary encodes tbl in a compact binary form. Strings are stored
+// once in a string table and referenced by index, so repeated signatures
+// (e.g. "unsigned int fd") do not increase the size of the encoding.
+func (tbl SyscallTable) MarshalBinary() ([]byte, error) {
+	var (
+		strs []string
+		idx  = map[string]int{}
+	)
+	str := func(s string) int {
+		i, ok := idx[s]
+		if !ok {
+			i = len(strs)
+			idx[s] = i
+			strs = append(strs, s)
+		}
+		return i
+	}
+
+	var body []byte
+	nums := tableNums(tbl)
+	body = binary.AppendVarint(body, int64(len(nums)))
+	for _, n := range nums {
+		sc := tbl[n]
+		body = appendInts(body, sc.Num, str(sc.Name), str(sc.Entry), int(sc.Context), int(sc.Categories))
+		body = appendInts(body, sc.Since.Major, sc.Since.Minor, sc.Since.Patch)
+		body = appendInts(body, sc.Until.Major, sc.Until.Minor, sc.Until.Patch)
+		body = appendInts(body, int(sc.Status), len(sc.Args))
+		for _, arg := range sc.Args {
+			body = appendInts(body, arg.RefCount, str(arg.Sig), int(arg.Context))
+		}
+	}
+
+	data := []byte(binaryMagic)
+	data = binary.AppendVarint(data, int64(len(strs)))
+	for _, s := range strs {
+		data = binary.AppendVarint(data, int64(len(s)))
+		data = append(data, s...)
+	}
+	return append(data, body...), nil
+}
+
+func appendInts(b []byte, vs ...int) []byte {
+	for _, v := range vs {
+		b = binary.AppendVarint(b, int64(v))
+	}
+	return b
+}
+
+// UnmarshalBinary decodes a syscall table encoded by MarshalBinary. If the
+// data is not valid, it returns ErrInvalidTable.
+func (tbl *SyscallTable) UnmarshalBinary(data []byte) error {
+	if len(data) < len(binaryMagic) || string(data[:len(binaryMagic)]) != binaryMagic {
+		return ErrInvalidTable
+	}
+	d := &decoder{data: data[len(binaryMagic):]}
+
+	strs := make([]string, d.count())
+	for i := range strs {
+		n := d.count()
+		if d.err != nil || n > len(d.data) {
+			return ErrInvalidTable
+		}
+		strs[i] = string(d.data[:n])
+		d.data = d.data[n:]
+	}
+	str := func() string {
+		i := d.int()
+		if i < 0 || i >= len(strs) {
+			d.err = ErrInvalidTable
+			return ""
+		}
+		return strs[i]
+	}
+
+	n := d.count()
+	t := make(SyscallTable, n)
+	for i := 0; i < n && d.err == nil; i++ {
+		sc := Syscall{
+			Num:        d.int(),
+			Name:       str(),
+			Entry:      str(),
+			Context:    Context(d.int()),
+			Categories: Category(d.int()),
+			Since:      KernelVersion{Major: d.int(), Minor: d.int(), Patch: d.int()},
+			Until:      KernelVersion{Major: d.int(), Minor: d.int(), Patch: d.int()},
+			Status:     SyscallStatus(d.int()),
+		}
+		sc.Args = make([]Argument, d.count())
+		for j := range sc.Args {
+			sc.Args[j] = Argument{RefCount: d.int(), Sig: str(), Context: Context(d.int())}
+		}
+		t[sc.Num] = sc
+	}
+	if d.err != nil || len(d.data) != 0 {
+		return ErrInvalidTable
+	}
+	*tbl = t
+	return nil
+}
+
+// A decoder reads the varints of a binary syscall table. After the first
+// error, every read returns zero.
+type decoder struct {
+	data []byte
+	err  error
+}
+
+func (d *decoder) int() int {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.data)
+	if n <= 0 {
+		d.err = ErrInvalidTable
+		return 0
+	}
+	d.data = d.data[n:]
+	return int(v)
+}
+
+// count reads a number of elements. Every element takes at least one byte,
+// so a count greater than the remaining data is an error.
+func (d *decoder) count() int {
+	n := d.int()
+	if n < 0 || n > len(d.data) {
+		d.err = ErrInvalidTable
+		return 0
+	}
+	return n
+}
+
+// MustUnmarshalTable decodes a syscall table encoded by
+// SyscallTable.MarshalBinary. If the data is not valid, it panics. It is used
+// by the table packages generated in embed mode.
+func MustUnmarshalTable(data []byte) SyscallTable {
+	var tbl SyscallTable
+	if err := tbl.UnmarshalBinary(data); err != nil {
+		panic("syscallinfo: MustUnmarshalTable: " + err.Error())
+	}
+	return tbl
+}
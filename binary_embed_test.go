@@ -0,0 +1,40 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build syscallinfo_embed
+// +build syscallinfo_embed
+
+package syscallinfo_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/jroimartin/syscallinfo"
+)
+
+// BenchmarkInit measures the work done by the init of the table packages
+// in embed mode, which decodes their embedded tables. The init time of the
+// default build can be obtained with embedstats.go.
+func BenchmarkInit(b *testing.B) {
+	var data [][]byte
+	size := 0
+	for _, check := range checksEmbedData {
+		d, err := ioutil.ReadFile(filepath.FromSlash(check.file))
+		if err != nil {
+			b.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		data = append(data, d)
+		size += len(d)
+	}
+	b.SetBytes(int64(size))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, d := range data {
+			syscallinfo.MustUnmarshalTable(d)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/jroimartin/syscallinfo"
+	"github.com/jroimartin/syscallinfo/linux_386"
+	"github.com/jroimartin/syscallinfo/linux_amd64"
+	"github.com/jroimartin/syscallinfo/linux_arm"
+	"github.com/jroimartin/syscallinfo/linux_arm64"
+	"github.com/jroimartin/syscallinfo/linux_riscv64"
+)
+
+var checksBinary = []struct {
+	name string
+	tbl  syscallinfo.SyscallTable
+}{
+	{"linux_386", linux_386.SyscallTable},
+	{"linux_386 4.0", linux_386.SyscallTable4_0},
+	{"linux_amd64", linux_amd64.SyscallTable},
+	{"linux_amd64 4.0", linux_amd64.SyscallTable4_0},
+}
+
+func TestMarshalBinary(t *testing.T) {
+	for _, check := range checksBinary {
+		data, err := check.tbl.MarshalBinary()
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		var tbl syscallinfo.SyscallTable
+		if err := tbl.UnmarshalBinary(data); err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		if !reflect.DeepEqual(tbl, check.tbl) {
+			t.Errorf("%v: decoded table differs from the original one", check.name)
+		}
+	}
+}
+
+func TestUnmarshalBinary_invalid(t *testing.T) {
+	data, err := linux_amd64.SyscallTable.MarshalBinary()
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	checks := [][]byte{
+		nil,
+		[]byte("SCT\x02"),
+		data[:len(data)/2],
+		append(append([]byte{}, data...), 0),
+	}
+	for _, check := range checks {
+		var tbl syscallinfo.SyscallTable
+		if err := tbl.UnmarshalBinary(check); err != syscallinfo.ErrInvalidTable {
+			t.Errorf("wrong error (want=%v, get=%v)", syscallinfo.ErrInvalidTable, err)
+		}
+	}
+}
+
+func TestMustUnmarshalTable(t *testing.T) {
+	data, err := linux_386.SyscallTable.MarshalBinary()
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	if tbl := syscallinfo.MustUnmarshalTable(data); !reflect.DeepEqual(tbl, linux_386.SyscallTable) {
+		t.Error("decoded table differs from the original one")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("invalid data did not panic")
+		}
+	}()
+	syscallinfo.MustUnmarshalTable(data[:len(data)/2])
+}
+
+var checksEmbedData = []struct {
+	file string
+	tbl  syscallinfo.SyscallTable
+}{
+	{"linux_386/syscalltable_embed.bin", linux_386.SyscallTable},
+	{"linux_386/syscalltable_4_0_embed.bin", linux_386.SyscallTable4_0},
+	{"linux_amd64/syscalltable_embed.bin", linux_amd64.SyscallTable},
+	{"linux_amd64/syscalltable_4_0_embed.bin", linux_amd64.SyscallTable4_0},
+	{"linux_arm/syscalltable_embed.bin", linux_arm.SyscallTable},
+	{"linux_arm64/syscalltable_embed.bin", linux_arm64.SyscallTable},
+	{"linux_riscv64/syscalltable_embed.bin", linux_riscv64.SyscallTable},
+}
+
+// TestEmbedData checks that the data embedded in the table packages matches
+// the tables, so both build modes provide the same tables.
+func TestEmbedData(t *testing.T) {
+	for _, check := range checksEmbedData {
+		data, err := ioutil.ReadFile(filepath.FromSlash(check.file))
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		var tbl syscallinfo.SyscallTable
+		if err := tbl.UnmarshalBinary(data); err != nil {
+			t.Errorf("%v: wrong error (want=nil, get=%v)", check.file, err)
+			continue
+		}
+		if !reflect.DeepEqual(tbl, check.tbl) {
+			t.Errorf("%v: embedded table differs from the package table", check.file)
+		}
+	}
+}
+
+// BenchmarkLookup measures the lookups of the amd64 table. Run it with and
+// without the syscallinfo_embed tag to compare both build modes.
+func BenchmarkLookup(b *testing.B) {
+	benchmarkLookup(b, linux_amd64.SyscallTable)
+}
+
+func benchmarkLookup(b *testing.B, tbl syscallinfo.SyscallTable) {
+	r := syscallinfo.NewResolver(tbl)
+	names := []string{"read", "openat", "clone3", "io_uring_setup"}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := r.SyscallN(i % 335); err != nil {
+			b.Fatal(err)
+		}
+		if _, err := r.SyscallName(names[i%len(names)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// Category classifies syscalls by their purpose. A syscall can belong to
+// several categories, so categories can be combined as a bit mask. They match
+// the syscall classes used by strace (%file, %network, etc.).
+type Category int
+
+const (
+	// CatFile represents syscalls that take a file name as argument.
+	CatFile Category = 1 << iota
+	// CatDesc represents syscalls that take or return a file descriptor.
+	CatDesc
+	// CatNetwork represents network related syscalls.
+	CatNetwork
+	// CatIPC represents SysV IPC related syscalls.
+	CatIPC
+	// CatProcess represents process management syscalls.
+	CatProcess
+	// CatSignal represents signal related syscalls.
+	CatSignal
+	// CatMemory represents memory mapping related syscalls.
+	CatMemory
+	// CatClock represents syscalls that read or modify system clocks.
+	CatClock
+	// CatCreds represents syscalls that read or modify user and group
+	// identifiers or capability sets.
+	CatCreds
+	// CatStat represents stat syscall variants.
+	CatStat
+	// CatLstat represents lstat syscall variants.
+	CatLstat
+	// CatFstat represents fstat and fstatat syscall variants.
+	CatFstat
+	// CatStatLike represents stat, lstat, fstat and fstatat syscall
+	// variants.
+	CatStatLike
+	// CatStatfs represents statfs syscall variants.
+	CatStatfs
+	// CatFstatfs represents fstatfs syscall variants.
+	CatFstatfs
+	// CatStatfsLike represents statfs, fstatfs and ustat syscall variants.
+	CatStatfsLike
+	// CatPure represents syscalls that always succeed and have no
+	// arguments.
+	CatPure
+)
+
+var categoryNames = []struct {
+	cat   Category
+	name  string
+	ident string
+}{
+	{CatFile, "file", "CatFile"},
+	{CatDesc, "desc", "CatDesc"},
+	{CatNetwork, "network", "CatNetwork"},
+	{CatIPC, "ipc", "CatIPC"},
+	{CatProcess, "process", "CatProcess"},
+	{CatSignal, "signal", "CatSignal"},
+	{CatMemory, "memory", "CatMemory"},
+	{CatClock, "clock", "CatClock"},
+	{CatCreds, "creds", "CatCreds"},
+	{CatStat, "stat", "CatStat"},
+	{CatLstat, "lstat", "CatLstat"},
+	{CatFstat, "fstat", "CatFstat"},
+	{CatStatLike, "stat_like", "CatStatLike"},
+	{CatStatfs, "statfs", "CatStatfs"},
+	{CatFstatfs, "fstatfs", "CatFstatfs"},
+	{CatStatfsLike, "statfs_like", "CatStatfsLike"},
+	{CatPure, "pure", "CatPure"},
+}
+
+// ParseCategory returns the category with the given name (e.g. "file" or
+// "network").
+func ParseCategory(name string) (Category, error) {
+	for _, cn := range categoryNames {
+		if cn.name == name {
+			return cn.cat, nil
+		}
+	}
+	return 0, &ParseError{Kind: "category", Value: name}
+}
+
+// Names returns the names of the categories included in cat.
+func (cat Category) Names() []string {
+	var names []string
+	for _, cn := range categoryNames {
+		if cat&cn.cat != 0 {
+			names = append(names, cn.name)
+		}
+	}
+	return names
+}
+
+// String returns the names of the categories included in cat separated by
+// "|".
+func (cat Category) String() string {
+	return strings.Join(cat.Names(), "|")
+}
+
+// GoString returns a Go expression that represents cat. It is used by the
+// table generator.
+func (cat Category) GoString() string {
+	var consts []string
+	for _, cn := range categoryNames {
+		if cat&cn.cat != 0 {
+			consts = append(consts, "syscallinfo."+cn.ident)
+		}
+	}
+	if len(consts) == 0 {
+		return "0"
+	}
+	return strings.Join(consts, " | ")
+}
+
+// A CategoryAnnotation maps category names to the names of the syscalls that
+// belong to them. It is the format of the annotation file used by
+// mksyscalltable.go.
+type CategoryAnnotation map[string][]string
+
+// ParseCategoryAnnotation parses a JSON category annotation file.
+func ParseCategoryAnnotation(data []byte) (CategoryAnnotation, error) {
+	ann := CategoryAnnotation{}
+	if err := json.Unmarshal(data, &ann); err != nil {
+		return nil, err
+	}
+	for name := range ann {
+		if _, err := ParseCategory(name); err != nil {
+			return nil, err
+		}
+	}
+	return ann, nil
+}
+
+// Categories returns the categories of the syscall with the given name.
+func (ann CategoryAnnotation) Categories(name string) Category {
+	var cat Category
+	for catName, names := range ann {
+		for _, n := range names {
+			if n == name {
+				c, _ := ParseCategory(catName)
+				cat |= c
+				break
+			}
+		}
+	}
+	return cat
+}
+
+// SyscallsByCategory returns all the syscalls that belong to any of the
+// categories included in cat, sorted by number.
+func (r Resolver) SyscallsByCategory(cat Category) []Syscall {
+	var scs []Syscall
+	for _, sc := range r.tbl {
+		if sc.Categories&cat != 0 {
+			scs = append(scs, sc)
+		}
+	}
+	sort.Sort(byNum(scs))
+	return scs
+}
+
+type byNum []Syscall
+
+func (s byNum) Len() int           { return len(s) }
+func (s byNum) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byNum) Less(i, j int) bool { return s[i].Num < s[j].Num }
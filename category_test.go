@@ -0,0 +1,84 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo_test
+
+import (
+	"testing"
+
+	"github.com/jroimartin/syscallinfo"
+	"github.com/jroimartin/syscallinfo/linux_386"
+	"github.com/jroimartin/syscallinfo/linux_amd64"
+)
+
+var checksCategory = []struct {
+	tbl      syscallinfo.SyscallTable
+	category string
+	included []string
+	excluded []string
+}{
+	{
+		linux_386.SyscallTable,
+		"network",
+		[]string{"socketcall"},
+		[]string{"read", "ipc"},
+	},
+	{
+		linux_amd64.SyscallTable,
+		"network",
+		[]string{"socket", "connect", "accept4"},
+		[]string{"read", "socketcall"},
+	},
+	{
+		linux_amd64.SyscallTable,
+		"file",
+		[]string{"open", "openat", "execve"},
+		[]string{"read", "close"},
+	},
+	{
+		linux_amd64.SyscallTable,
+		"stat_like",
+		[]string{"stat", "lstat", "fstat", "newfstatat"},
+		[]string{"statfs"},
+	},
+}
+
+func TestResolver_SyscallsByCategory(t *testing.T) {
+	for _, check := range checksCategory {
+		cat, err := syscallinfo.ParseCategory(check.category)
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		r := syscallinfo.NewResolver(check.tbl)
+		names := map[string]bool{}
+		prev := -1
+		for _, sc := range r.SyscallsByCategory(cat) {
+			if sc.Num <= prev {
+				t.Errorf("syscalls not sorted (%v after %v)", sc.Num, prev)
+			}
+			prev = sc.Num
+			names[sc.Name] = true
+		}
+		for _, name := range check.included {
+			if !names[name] {
+				t.Errorf("%v should be in category %v", name, check.category)
+			}
+		}
+		for _, name := range check.excluded {
+			if names[name] {
+				t.Errorf("%v should not be in category %v", name, check.category)
+			}
+		}
+	}
+}
+
+func TestParseCategory(t *testing.T) {
+	if _, err := syscallinfo.ParseCategory("foobar"); err == nil {
+		t.Errorf("wrong error (want=non-nil, get=nil)")
+	}
+	cat := syscallinfo.CatFile | syscallinfo.CatDesc
+	if cat.String() != "file|desc" {
+		t.Errorf("wrong string (want=file|desc, get=%v)", cat.String())
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/jroimartin/syscallinfo"
+)
+
+// cmdDiff compares two syscall tables.
+func cmdDiff(opts options, args []string, w io.Writer) error {
+	if len(args) != 2 {
+		return fmt.Errorf("two tables are required")
+	}
+	old, err := parseTableSpec(args[0])
+	if err != nil {
+		return err
+	}
+	new, err := parseTableSpec(args[1])
+	if err != nil {
+		return err
+	}
+
+	var d syscallinfo.TableDiff
+	switch opts.by {
+	case "num", "":
+		d = syscallinfo.DiffTables(old, new)
+	case "name":
+		d = syscallinfo.DiffTablesByName(old, new)
+	default:
+		return fmt.Errorf("invalid match mode %q", opts.by)
+	}
+
+	if opts.json {
+		return writeDiffJSON(w, d)
+	}
+	return writeDiff(w, d)
+}
+
+// parseTableSpec returns the table specified by spec, which has the form
+// "arch" or "arch@release".
+func parseTableSpec(spec string) (syscallinfo.SyscallTable, error) {
+	arch, release := spec, ""
+	if i := strings.Index(spec, "@"); i >= 0 {
+		arch, release = spec[:i], spec[i+1:]
+	}
+	tbl, _, err := tableFor(arch, release)
+	return tbl, err
+}
+
+// jsonChange is the JSON representation of a changed syscall.
+type jsonChange struct {
+	Old syscallinfo.Syscall `json:"old"`
+	New syscallinfo.Syscall `json:"new"`
+}
+
+// jsonDiff is the JSON representation of a table diff.
+type jsonDiff struct {
+	Added   []syscallinfo.Syscall `json:"added"`
+	Removed []syscallinfo.Syscall `json:"removed"`
+	Changed []jsonChange          `json:"changed"`
+}
+
+// writeDiffJSON writes d as an indented JSON object.
+func writeDiffJSON(w io.Writer, d syscallinfo.TableDiff) error {
+	jd := jsonDiff{
+		Added:   append([]syscallinfo.Syscall{}, d.Added...),
+		Removed: append([]syscallinfo.Syscall{}, d.Removed...),
+		Changed: []jsonChange{},
+	}
+	for _, c := range d.Changed {
+		jd.Changed = append(jd.Changed, jsonChange{Old: c.Old, New: c.New})
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(jd)
+}
+
+// writeDiff writes d as a unified diff. Added syscalls are written as a line
+// prefixed with "+" and removed ones as a line prefixed with "-". Changed
+// syscalls are written as a "-" line with the old version followed by a "+"
+// line with the new one and, if their status changed, a status line.
+func writeDiff(w io.Writer, d syscallinfo.TableDiff) error {
+	for _, sc := range d.Added {
+		fmt.Fprintf(w, "+ %d %s\n", sc.Num, sc.Prototype())
+	}
+	for _, sc := range d.Removed {
+		fmt.Fprintf(w, "- %d %s\n", sc.Num, sc.Prototype())
+	}
+	for _, c := range d.Changed {
+		fmt.Fprintf(w, "- %d %s\n", c.Old.Num, c.Old.Prototype())
+		fmt.Fprintf(w, "+ %d %s\n", c.New.Num, c.New.Prototype())
+		if c.Old.Status != c.New.Status {
+			fmt.Fprintf(w, "  status: %s -> %s\n", statusName(c.Old.Status), statusName(c.New.Status))
+		}
+	}
+	return nil
+}
+
+// statusName returns the name of st, which is "OK" for SyscallOK.
+func statusName(st syscallinfo.SyscallStatus) string {
+	if st == syscallinfo.SyscallOK {
+		return "OK"
+	}
+	return st.String()
+}
@@ -0,0 +1,58 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/jroimartin/syscallinfo"
+	"github.com/jroimartin/syscallinfo/export"
+)
+
+// exporters contains the function that implements each export format.
+var exporters = map[string]func(w io.Writer, name string, tbl syscallinfo.SyscallTable) error{
+	"c":        export.WriteC,
+	"rust":     export.WriteRust,
+	"python":   export.WritePython,
+	"markdown": reference(export.WriteMarkdown),
+	"html":     reference(export.WriteHTML),
+}
+
+// reference returns an export function that writes a reference page with
+// the equivalent syscalls of every other registered arch.
+func reference(write func(w io.Writer, name string, tbl syscallinfo.SyscallTable, others ...export.NamedTable) error) func(io.Writer, string, syscallinfo.SyscallTable) error {
+	return func(w io.Writer, name string, tbl syscallinfo.SyscallTable) error {
+		var others []export.NamedTable
+		for _, arch := range syscallinfo.Arches() {
+			if arch == name {
+				continue
+			}
+			otbl, err := syscallinfo.Table(arch)
+			if err != nil {
+				return err
+			}
+			others = append(others, export.NamedTable{Name: arch, Table: otbl})
+		}
+		return write(w, name, tbl, others...)
+	}
+}
+
+// cmdExport writes the syscall table in the format selected by opts.
+func cmdExport(opts options, args []string, w io.Writer) error {
+	if len(args) != 0 {
+		return fmt.Errorf("unexpected arguments: %v", strings.Join(args, " "))
+	}
+	write, ok := exporters[opts.format]
+	if !ok {
+		return fmt.Errorf("unknown format %q", opts.format)
+	}
+	tbl, _, err := table(opts)
+	if err != nil {
+		return err
+	}
+	return write(w, archName(opts.arch), tbl)
+}
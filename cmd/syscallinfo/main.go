@@ -0,0 +1,314 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Command syscallinfo prints information about the syscalls of the registered
+syscall tables.
+
+Usage:
+
+	syscallinfo [flags] number|name|entry
+	syscallinfo list [flags]
+	syscallinfo grep [flags] regexp
+	syscallinfo diff [flags] old new
+	syscallinfo export [flags]
+
+The first form shows the syscalls with the provided number, name or entry
+point. The list command lists all the syscalls of the table, optionally
+filtered by category. The grep command lists the syscalls whose name, entry
+point or argument signatures match the provided regular expression. The diff
+command compares two tables, which are specified as "arch" or
+"arch@release" (e.g. "amd64@4.0"), and reports the added, removed and changed
+syscalls. The export command writes the table as a C header, a Rust module
+or a Python module, or as a Markdown or HTML reference page that includes
+the equivalent syscalls of the other arches.
+
+Flags:
+
+	-arch string
+		arch of the syscall table, e.g. 386 or amd64 (default "amd64")
+	-release string
+		kernel release of the table snapshot (latest table if omitted)
+	-json
+		print JSON instead of text
+	-category string
+		category of the listed syscalls (list only)
+	-by string
+		match syscalls by "num" or "name" (diff only, default "num")
+	-format string
+		output format: c, rust, python, markdown or html (export only,
+		default "c")
+
+Flags can be placed before or after the command name. For instance:
+
+	syscallinfo -arch amd64 257
+	syscallinfo -arch 386 openat
+	syscallinfo list -category network
+	syscallinfo -json grep fd
+	syscallinfo diff amd64@4.0 amd64
+	syscallinfo diff -by name 386 amd64
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jroimartin/syscallinfo"
+	_ "github.com/jroimartin/syscallinfo/linux_386"
+	_ "github.com/jroimartin/syscallinfo/linux_amd64"
+	_ "github.com/jroimartin/syscallinfo/linux_arm"
+	_ "github.com/jroimartin/syscallinfo/linux_arm64"
+	_ "github.com/jroimartin/syscallinfo/linux_riscv64"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// options contains the values of the command line flags.
+type options struct {
+	arch     string
+	release  string
+	json     bool
+	category string
+	by       string
+	format   string
+}
+
+// commands contains the functions that implement each command. They receive
+// the positional arguments that follow the command name.
+var commands = map[string]func(opts options, args []string, w io.Writer) error{
+	"list":   cmdList,
+	"grep":   cmdGrep,
+	"diff":   cmdDiff,
+	"export": cmdExport,
+}
+
+// run executes the command line args and returns the exit status.
+func run(args []string, stdout, stderr io.Writer) int {
+	opts := options{}
+	fs := newFlagSet("syscallinfo", &opts, stderr)
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	args = fs.Args()
+
+	cmd := cmdShow
+	if len(args) > 0 {
+		if c, ok := commands[args[0]]; ok {
+			fs = newFlagSet("syscallinfo "+args[0], &opts, stderr)
+			switch args[0] {
+			case "list":
+				fs.StringVar(&opts.category, "category", "", "category of the listed syscalls")
+			case "diff":
+				fs.StringVar(&opts.by, "by", "num", `match syscalls by "num" or "name"`)
+			case "export":
+				fs.StringVar(&opts.format, "format", "c", "output format: c, rust, python, markdown or html")
+			}
+			if err := fs.Parse(args[1:]); err != nil {
+				return 2
+			}
+			cmd, args = c, fs.Args()
+		}
+	}
+
+	if err := cmd(opts, args, stdout); err != nil {
+		fmt.Fprintln(stderr, "syscallinfo:", err)
+		return 1
+	}
+	return 0
+}
+
+// newFlagSet returns a flag set with the flags shared by all the commands.
+func newFlagSet(name string, opts *options, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&opts.arch, "arch", opts.archOrDefault(), "arch of the syscall table, e.g. 386 or amd64")
+	fs.StringVar(&opts.release, "release", opts.release, "kernel release of the table snapshot (latest table if omitted)")
+	fs.BoolVar(&opts.json, "json", opts.json, "print JSON instead of text")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: syscallinfo [flags] number|name|entry")
+		fmt.Fprintln(stderr, "       syscallinfo list [flags]")
+		fmt.Fprintln(stderr, "       syscallinfo grep [flags] regexp")
+		fmt.Fprintln(stderr, "       syscallinfo diff [flags] old new")
+		fmt.Fprintln(stderr, "       syscallinfo export [flags]")
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+func (opts options) archOrDefault() string {
+	if opts.arch == "" {
+		return "amd64"
+	}
+	return opts.arch
+}
+
+// archAliases maps common arch names to the ones used by syscallinfo.
+var archAliases = map[string]string{
+	"x86_64":  "amd64",
+	"x86":     "386",
+	"i386":    "386",
+	"i686":    "386",
+	"aarch64": "arm64",
+}
+
+// archName returns the name of the registered arch referred by arch (e.g.
+// "linux_amd64" for "amd64" or "x86_64").
+func archName(arch string) string {
+	if strings.HasPrefix(arch, "linux_") {
+		return arch
+	}
+	if alias, ok := archAliases[arch]; ok {
+		arch = alias
+	}
+	return "linux_" + arch
+}
+
+// table returns the syscall table selected by opts.
+func table(opts options) (syscallinfo.SyscallTable, syscallinfo.Resolver, error) {
+	return tableFor(opts.arch, opts.release)
+}
+
+// tableFor returns the syscall table of the provided arch. If release is not
+// empty, the corresponding snapshot is returned.
+func tableFor(arch, release string) (syscallinfo.SyscallTable, syscallinfo.Resolver, error) {
+	arch = archName(arch)
+	if release == "" {
+		r, err := syscallinfo.NewArchResolver(arch)
+		if err != nil {
+			return nil, syscallinfo.Resolver{}, err
+		}
+		tbl, err := syscallinfo.Table(arch)
+		return tbl, r, err
+	}
+	v, err := syscallinfo.ParseKernelVersion(release)
+	if err != nil {
+		return nil, syscallinfo.Resolver{}, err
+	}
+	r, err := syscallinfo.NewSnapshotResolver(arch, v)
+	if err != nil {
+		return nil, syscallinfo.Resolver{}, err
+	}
+	tbl, err := syscallinfo.Snapshot(arch, v)
+	return tbl, r, err
+}
+
+// cmdShow prints the syscalls with the provided number, name or entry point.
+func cmdShow(opts options, args []string, w io.Writer) error {
+	if len(args) != 1 {
+		return fmt.Errorf("a syscall number, name or entry is required")
+	}
+	tbl, r, err := table(opts)
+	if err != nil {
+		return err
+	}
+
+	var scs []syscallinfo.Syscall
+	if n, err := strconv.Atoi(args[0]); err == nil {
+		sc, err := r.SyscallN(n)
+		if err != nil {
+			return err
+		}
+		scs = append(scs, sc)
+	} else {
+		for _, sc := range tbl {
+			if sc.Name == args[0] || sc.Entry == args[0] {
+				scs = append(scs, sc)
+			}
+		}
+		if len(scs) == 0 {
+			_, err := r.SyscallName(args[0])
+			return err
+		}
+		sortSyscalls(scs)
+	}
+
+	if opts.json {
+		return writeJSON(w, scs)
+	}
+	for i, sc := range scs {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		writeDetails(w, sc)
+	}
+	return nil
+}
+
+// cmdList lists the syscalls of the table, optionally filtered by category.
+func cmdList(opts options, args []string, w io.Writer) error {
+	if len(args) != 0 {
+		return fmt.Errorf("unexpected arguments: %v", strings.Join(args, " "))
+	}
+	tbl, r, err := table(opts)
+	if err != nil {
+		return err
+	}
+
+	var scs []syscallinfo.Syscall
+	if opts.category != "" {
+		cat, err := syscallinfo.ParseCategory(opts.category)
+		if err != nil {
+			return err
+		}
+		scs = r.SyscallsByCategory(cat)
+	} else {
+		for _, sc := range tbl {
+			scs = append(scs, sc)
+		}
+		sortSyscalls(scs)
+	}
+	return writeList(w, scs, opts.json)
+}
+
+// cmdGrep lists the syscalls whose name, entry point or argument signatures
+// match a regular expression.
+func cmdGrep(opts options, args []string, w io.Writer) error {
+	if len(args) != 1 {
+		return fmt.Errorf("a regular expression is required")
+	}
+	re, err := regexp.Compile(args[0])
+	if err != nil {
+		return err
+	}
+	tbl, _, err := table(opts)
+	if err != nil {
+		return err
+	}
+
+	var scs []syscallinfo.Syscall
+	for _, sc := range tbl {
+		if grepSyscall(re, sc) {
+			scs = append(scs, sc)
+		}
+	}
+	sortSyscalls(scs)
+	return writeList(w, scs, opts.json)
+}
+
+// grepSyscall reports whether the name, entry point or any argument
+// signature of sc matches re.
+func grepSyscall(re *regexp.Regexp, sc syscallinfo.Syscall) bool {
+	if re.MatchString(sc.Name) || re.MatchString(sc.Entry) {
+		return true
+	}
+	for _, arg := range sc.Args {
+		if re.MatchString(arg.Sig) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortSyscalls(scs []syscallinfo.Syscall) {
+	sort.Slice(scs, func(i, j int) bool { return scs[i].Num < scs[j].Num })
+}
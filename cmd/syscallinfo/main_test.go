@@ -0,0 +1,96 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/jroimartin/syscallinfo"
+)
+
+var checksRun = []struct {
+	args   []string
+	status int
+	want   []string
+}{
+	{[]string{"-arch", "amd64", "257"}, 0, []string{"Name:       openat", "Since:      2.6.16"}},
+	{[]string{"-arch", "386", "openat"}, 0, []string{"Num:        295"}},
+	{[]string{"-arch", "x86_64", "ioctl"}, 0, []string{"Num:        16", "Num:        514", "Arg 1:      IOCTL_REQ"}},
+	{[]string{"list", "-category", "network"}, 0, []string{"42\tconnect(", "288\taccept4("}},
+	{[]string{"-arch", "386", "list", "-category", "ipc"}, 0, []string{"117\tipc("}},
+	{[]string{"grep", "open_how"}, 0, []string{"437\topenat2("}},
+	{[]string{"-release", "4.0", "statx"}, 1, nil},
+	{[]string{"-arch", "arm64", "read"}, 0, []string{"Num:        63"}},
+	{[]string{"-arch", "aarch64", "renameat"}, 0, []string{"Num:        38"}},
+	{[]string{"-arch", "riscv64", "renameat"}, 1, nil},
+	{[]string{"-arch", "arm", "arm_fadvise64_64"}, 0, []string{"Num:        270"}},
+	{[]string{"-arch", "mips", "read"}, 1, nil},
+	{[]string{"list", "-category", "foo"}, 1, nil},
+	{[]string{"-foo"}, 2, nil},
+	{[]string{"diff", "amd64@4.0", "amd64"}, 0, []string{"+ 332 statx(", "+ 435 clone3(", "- 156 _sysctl(struct __sysctl_args __user *args)\n+ 156 _sysctl()\n  status: OK -> NOT_IMPLEMENTED\n"}},
+	{[]string{"diff", "-by", "name", "386", "amd64"}, 0, []string{"- 295 openat(", "+ 257 openat("}},
+	{[]string{"diff", "amd64", "amd64@3.0"}, 1, nil},
+	{[]string{"diff", "-by", "foo", "386", "amd64"}, 1, nil},
+	{[]string{"-arch", "386", "export"}, 0, []string{"#define SYSCALLINFO_LINUX_386_NR_socketcall 102\n"}},
+	{[]string{"export", "-format", "rust"}, 0, []string{"pub const SYS_OPENAT2: u32 = 437;"}},
+	{[]string{"export", "-format", "python", "-release", "4.0"}, 0, []string{"SYS_EXECVEAT = 322\n"}},
+	{[]string{"export", "-format", "markdown"}, 0, []string{"| 43 | accept | sys_accept |", "| 102 (socketcall 5) | 285 | 202 | 202 |\n"}},
+	{[]string{"export", "-format", "html"}, 0, []string{"<th>linux_386</th>"}},
+	{[]string{"export", "-format", "java"}, 1, nil},
+}
+
+func TestRun(t *testing.T) {
+	for _, check := range checksRun {
+		var stdout, stderr bytes.Buffer
+		status := run(check.args, &stdout, &stderr)
+		if status != check.status {
+			t.Errorf("wrong status for %v (want=%v, get=%v): %s", check.args, check.status, status, stderr.String())
+			continue
+		}
+		for _, want := range check.want {
+			if !strings.Contains(stdout.String(), want) {
+				t.Errorf("wrong output for %v (want=%q, get=%q)", check.args, want, stdout.String())
+			}
+		}
+	}
+}
+
+func TestRun_json(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if status := run([]string{"-json", "-arch", "386", "3"}, &stdout, &stderr); status != 0 {
+		t.Fatalf("wrong status (want=0, get=%v): %s", status, stderr.String())
+	}
+	var scs []syscallinfo.Syscall
+	if err := json.Unmarshal(stdout.Bytes(), &scs); err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	if len(scs) != 1 || scs[0].Name != "read" || scs[0].Args[0].Context != syscallinfo.CtxFD {
+		t.Errorf("wrong output (want=read with FD arg, get=%+v)", scs)
+	}
+}
+
+func TestRun_diffJSON(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if status := run([]string{"-json", "diff", "386@4.0", "386"}, &stdout, &stderr); status != 0 {
+		t.Fatalf("wrong status (want=0, get=%v): %s", status, stderr.String())
+	}
+	var d jsonDiff
+	if err := json.Unmarshal(stdout.Bytes(), &d); err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	added := map[string]bool{}
+	for _, sc := range d.Added {
+		added[sc.Name] = true
+	}
+	if !added["socket"] || !added["clock_gettime64"] {
+		t.Errorf("socket and clock_gettime64 should be added")
+	}
+	if len(d.Removed) != 0 {
+		t.Errorf("wrong number of removed syscalls (want=0, get=%v)", len(d.Removed))
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/jroimartin/syscallinfo"
+)
+
+// writeJSON writes scs as an indented JSON array, using the format of
+// syscallinfo.SyscallTable.
+func writeJSON(w io.Writer, scs []syscallinfo.Syscall) error {
+	if scs == nil {
+		scs = []syscallinfo.Syscall{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(scs)
+}
+
+// writeList writes a line per syscall with its number and prototype.
+func writeList(w io.Writer, scs []syscallinfo.Syscall, asJSON bool) error {
+	if asJSON {
+		return writeJSON(w, scs)
+	}
+	for _, sc := range scs {
+		fmt.Fprintf(w, "%d\t%s\n", sc.Num, sc.Prototype())
+	}
+	return nil
+}
+
+// writeDetails writes all the information about sc.
+func writeDetails(w io.Writer, sc syscallinfo.Syscall) {
+	fmt.Fprintf(w, "Num:        %d\n", sc.Num)
+	fmt.Fprintf(w, "Name:       %s\n", sc.Name)
+	fmt.Fprintf(w, "Entry:      %s\n", sc.Entry)
+	fmt.Fprintf(w, "Prototype:  %s\n", sc.Prototype())
+	for i, arg := range sc.Args {
+		if arg.Context != syscallinfo.CtxNone {
+			fmt.Fprintf(w, "Arg %d:      %v\n", i, arg.Context)
+		}
+	}
+	if sc.Context != syscallinfo.CtxNone {
+		fmt.Fprintf(w, "Returns:    %v\n", sc.Context)
+	}
+	if sc.Categories != 0 {
+		fmt.Fprintf(w, "Categories: %v\n", sc.Categories)
+	}
+	if !sc.Since.IsZero() {
+		fmt.Fprintf(w, "Since:      %v\n", sc.Since)
+	}
+	if !sc.Until.IsZero() {
+		fmt.Fprintf(w, "Until:      %v\n", sc.Until)
+	}
+	if sc.Status != syscallinfo.SyscallOK {
+		fmt.Fprintf(w, "Status:     %v\n", sc.Status)
+	}
+}
@@ -0,0 +1,366 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A Command is one of the commands accepted by a command-multiplexed
+// syscall (e.g. F_SETFL for fcntl). It works as a sub-signature that
+// replaces the arguments that follow the command argument.
+type Command struct {
+	// Value is the value of the command.
+	Value uint64
+
+	// Name is the name of the command.
+	Name string
+
+	// Context specifies under which context the return value of the
+	// command is used.
+	Context Context
+
+	// Args contains the arguments that follow the command argument.
+	Args []Argument
+}
+
+// A CommandSet describes how a syscall changes its meaning based on a
+// command argument.
+type CommandSet struct {
+	// Index is the index of the command argument.
+	Index int
+
+	// Shift and Mask are applied to the command argument to extract the
+	// command value: (arg >> Shift) & Mask. If Mask is 0, the whole
+	// argument is used.
+	Shift uint
+	Mask  uint64
+
+	// Format returns the representation of the command argument given the
+	// name of the command and the bits not included in the command value.
+	// If it is nil, the remaining bits are appended to the name as a hex
+	// number.
+	Format func(name string, rest uint64) string
+
+	// Commands contains the commands indexed by value.
+	Commands map[uint64]Command
+}
+
+// commandSets contains the command sets indexed by syscall name.
+var commandSets = map[string]*CommandSet{}
+
+// RegisterCommands assigns a command set to the syscalls with the provided
+// name. If the syscall already had one, it is replaced.
+func RegisterCommands(name string, cs *CommandSet) {
+	commandSets[name] = cs
+}
+
+// Commands returns the command set assigned to sc, or nil if it is not a
+// command-multiplexed syscall.
+func (sc Syscall) Commands() *CommandSet {
+	return commandSets[sc.Name]
+}
+
+// split returns the command value and the remaining bits of the command
+// argument arg.
+func (cs *CommandSet) split(arg uint64) (val, rest uint64) {
+	if cs.Mask == 0 {
+		return arg, 0
+	}
+	val = arg >> cs.Shift & cs.Mask
+	return val, arg &^ (cs.Mask << cs.Shift)
+}
+
+// Command returns the command selected by the command argument arg.
+func (cs *CommandSet) Command(arg uint64) (Command, bool) {
+	val, _ := cs.split(arg)
+	cmd, ok := cs.Commands[val]
+	return cmd, ok
+}
+
+// format returns the representation of the command argument arg.
+func (cs *CommandSet) format(cmd Command, arg uint64) string {
+	_, rest := cs.split(arg)
+	if cs.Format != nil {
+		return cs.Format(cmd.Name, rest)
+	}
+	if rest != 0 {
+		return fmt.Sprintf("%s|%#x", cmd.Name, rest)
+	}
+	return cmd.Name
+}
+
+// signature returns the arguments and the context of the return value of the
+// call. If the syscall is command-multiplexed and the command is known, the
+// arguments that follow the command argument are replaced by the ones of the
+// command, which is returned along with its command set. Otherwise, cs is
+// nil.
+func (scc *SyscallCall) signature() (args []Argument, ret Context, cs *CommandSet, cmd Command) {
+	args, ret = scc.sc.Args, scc.sc.Context
+	cs = scc.sc.Commands()
+	if cs == nil || cs.Index >= len(args) {
+		return args, ret, nil, Command{}
+	}
+	cmd, ok := cs.Command(scc.args[cs.Index])
+	if !ok || cs.Index+1+len(cmd.Args) > len(scc.args) {
+		return args, ret, nil, Command{}
+	}
+	cmdArgs := make([]Argument, 0, cs.Index+1+len(cmd.Args))
+	cmdArgs = append(cmdArgs, args[:cs.Index+1]...)
+	cmdArgs = append(cmdArgs, cmd.Args...)
+	return cmdArgs, cmd.Context, cs, cmd
+}
+
+// newCommand returns a Command with the provided argument signatures.
+func newCommand(val uint64, name string, sigs ...string) Command {
+	return Command{Value: val, Name: name, Args: newArgs(sigs...)}
+}
+
+// newArgs returns the arguments described by the provided signatures. Their
+// reference count is the number of "*" and arguments named fd are file
+// descriptors.
+func newArgs(sigs ...string) []Argument {
+	args := []Argument{}
+	for _, sig := range sigs {
+		arg := Argument{
+			RefCount: strings.Count(sig, "*"),
+			Sig:      sig,
+		}
+		if strings.HasSuffix(sig, " fd") {
+			arg.Context = CtxFD
+		}
+		args = append(args, arg)
+	}
+	return args
+}
+
+// withContext returns cmd after setting the context of its i-th argument.
+// If i is -1, the context of the return value is set.
+func withContext(cmd Command, i int, ctx Context) Command {
+	if i < 0 {
+		cmd.Context = ctx
+	} else {
+		cmd.Args[i].Context = ctx
+	}
+	return cmd
+}
+
+// newCommandSet returns a CommandSet with the provided commands.
+func newCommandSet(index int, cmds ...Command) *CommandSet {
+	cs := &CommandSet{Index: index, Commands: map[uint64]Command{}}
+	for _, cmd := range cmds {
+		cs.Commands[cmd.Value] = cmd
+	}
+	return cs
+}
+
+// Initialize the command sets of the well-known command-multiplexed
+// syscalls.
+func init() {
+	fcntl := newCommandSet(1,
+		withContext(newCommand(0, "F_DUPFD", "unsigned long arg"), -1, CtxFD),
+		newCommand(1, "F_GETFD"),
+		newCommand(2, "F_SETFD", "unsigned long arg"),
+		withContext(newCommand(3, "F_GETFL"), -1, CtxOpenFlags),
+		withContext(newCommand(4, "F_SETFL", "unsigned long arg"), 0, CtxOpenFlags),
+		newCommand(5, "F_GETLK", "struct flock __user *l"),
+		newCommand(6, "F_SETLK", "struct flock __user *l"),
+		newCommand(7, "F_SETLKW", "struct flock __user *l"),
+		newCommand(8, "F_SETOWN", "pid_t pid"),
+		newCommand(9, "F_GETOWN"),
+		withContext(newCommand(10, "F_SETSIG", "int sig"), 0, CtxSignal),
+		withContext(newCommand(11, "F_GETSIG"), -1, CtxSignal),
+		newCommand(12, "F_GETLK64", "struct flock64 __user *l"),
+		newCommand(13, "F_SETLK64", "struct flock64 __user *l"),
+		newCommand(14, "F_SETLKW64", "struct flock64 __user *l"),
+		newCommand(15, "F_SETOWN_EX", "struct f_owner_ex __user *owner"),
+		newCommand(16, "F_GETOWN_EX", "struct f_owner_ex __user *owner"),
+		newCommand(36, "F_OFD_GETLK", "struct flock __user *l"),
+		newCommand(37, "F_OFD_SETLK", "struct flock __user *l"),
+		newCommand(38, "F_OFD_SETLKW", "struct flock __user *l"),
+		newCommand(1024, "F_SETLEASE", "long type"),
+		newCommand(1025, "F_GETLEASE"),
+		newCommand(1026, "F_NOTIFY", "unsigned long events"),
+		withContext(newCommand(1030, "F_DUPFD_CLOEXEC", "unsigned long arg"), -1, CtxFD),
+		newCommand(1031, "F_SETPIPE_SZ", "unsigned long size"),
+		newCommand(1032, "F_GETPIPE_SZ"),
+		newCommand(1033, "F_ADD_SEALS", "unsigned int seals"),
+		newCommand(1034, "F_GET_SEALS"),
+	)
+	RegisterCommands("fcntl", fcntl)
+	RegisterCommands("fcntl64", fcntl)
+
+	RegisterCommands("prctl", newCommandSet(0,
+		withContext(newCommand(1, "PR_SET_PDEATHSIG", "int sig"), 0, CtxSignal),
+		newCommand(2, "PR_GET_PDEATHSIG", "int __user *sig"),
+		newCommand(3, "PR_GET_DUMPABLE"),
+		newCommand(4, "PR_SET_DUMPABLE", "unsigned long dumpable"),
+		newCommand(7, "PR_GET_KEEPCAPS"),
+		newCommand(8, "PR_SET_KEEPCAPS", "unsigned long keepcaps"),
+		newCommand(15, "PR_SET_NAME", "const char __user *name"),
+		newCommand(16, "PR_GET_NAME", "char __user *name"),
+		newCommand(21, "PR_GET_SECCOMP"),
+		newCommand(22, "PR_SET_SECCOMP", "unsigned long mode", "struct sock_fprog __user *filter"),
+		newCommand(23, "PR_CAPBSET_READ", "unsigned long cap"),
+		newCommand(24, "PR_CAPBSET_DROP", "unsigned long cap"),
+		newCommand(29, "PR_SET_TIMERSLACK", "unsigned long slack"),
+		newCommand(30, "PR_GET_TIMERSLACK"),
+		newCommand(35, "PR_SET_MM", "unsigned long opt", "unsigned long addr", "unsigned long arg4", "unsigned long arg5"),
+		newCommand(36, "PR_SET_CHILD_SUBREAPER", "unsigned long subreaper"),
+		newCommand(37, "PR_GET_CHILD_SUBREAPER", "int __user *subreaper"),
+		newCommand(38, "PR_SET_NO_NEW_PRIVS", "unsigned long nnp", "unsigned long arg3", "unsigned long arg4", "unsigned long arg5"),
+		newCommand(39, "PR_GET_NO_NEW_PRIVS", "unsigned long arg2", "unsigned long arg3", "unsigned long arg4", "unsigned long arg5"),
+		newCommand(40, "PR_GET_TID_ADDRESS", "int __user * __user *tid_addr"),
+		newCommand(41, "PR_SET_THP_DISABLE", "unsigned long disable", "unsigned long arg3", "unsigned long arg4", "unsigned long arg5"),
+		newCommand(42, "PR_GET_THP_DISABLE", "unsigned long arg2", "unsigned long arg3", "unsigned long arg4", "unsigned long arg5"),
+		newCommand(47, "PR_CAP_AMBIENT", "unsigned long op", "unsigned long cap", "unsigned long arg4", "unsigned long arg5"),
+		newCommand(0x53564d41, "PR_SET_VMA", "unsigned long attr", "unsigned long addr", "unsigned long size", "const char __user *name"),
+	))
+
+	RegisterCommands("arch_prctl", newCommandSet(0,
+		newCommand(0x1001, "ARCH_SET_GS", "unsigned long addr"),
+		newCommand(0x1002, "ARCH_SET_FS", "unsigned long addr"),
+		newCommand(0x1003, "ARCH_GET_FS", "unsigned long __user *addr"),
+		newCommand(0x1004, "ARCH_GET_GS", "unsigned long __user *addr"),
+		newCommand(0x1011, "ARCH_GET_CPUID"),
+		newCommand(0x1012, "ARCH_SET_CPUID", "unsigned long enable"),
+	))
+
+	futex := newCommandSet(1,
+		newCommand(0, "FUTEX_WAIT", "u32 val", "struct timespec __user *utime"),
+		newCommand(1, "FUTEX_WAKE", "u32 val"),
+		withContext(newCommand(2, "FUTEX_FD", "u32 val"), -1, CtxFD),
+		newCommand(3, "FUTEX_REQUEUE", "u32 val", "u32 val2", "u32 __user *uaddr2"),
+		newCommand(4, "FUTEX_CMP_REQUEUE", "u32 val", "u32 val2", "u32 __user *uaddr2", "u32 val3"),
+		newCommand(5, "FUTEX_WAKE_OP", "u32 val", "u32 val2", "u32 __user *uaddr2", "u32 val3"),
+		newCommand(6, "FUTEX_LOCK_PI", "u32 val", "struct timespec __user *utime"),
+		newCommand(7, "FUTEX_UNLOCK_PI"),
+		newCommand(8, "FUTEX_TRYLOCK_PI"),
+		newCommand(9, "FUTEX_WAIT_BITSET", "u32 val", "struct timespec __user *utime", "u32 __user *uaddr2", "u32 val3"),
+		newCommand(10, "FUTEX_WAKE_BITSET", "u32 val", "struct timespec __user *utime", "u32 __user *uaddr2", "u32 val3"),
+		newCommand(11, "FUTEX_WAIT_REQUEUE_PI", "u32 val", "struct timespec __user *utime", "u32 __user *uaddr2"),
+		newCommand(12, "FUTEX_CMP_REQUEUE_PI", "u32 val", "u32 val2", "u32 __user *uaddr2", "u32 val3"),
+		newCommand(13, "FUTEX_LOCK_PI2", "u32 val", "struct timespec __user *utime"),
+	)
+	futex.Mask = 0x7f
+	futex.Format = func(name string, rest uint64) string {
+		if rest == 0 {
+			return name
+		}
+		return name + "|" + formatFlags(rest, []flagName{
+			{128, "FUTEX_PRIVATE_FLAG"},
+			{256, "FUTEX_CLOCK_REALTIME"},
+		})
+	}
+	RegisterCommands("futex", futex)
+
+	ptraceArgs := []string{"long pid", "unsigned long addr", "unsigned long data"}
+	ptrace := newCommandSet(0,
+		newCommand(0, "PTRACE_TRACEME"),
+		newCommand(1, "PTRACE_PEEKTEXT", ptraceArgs...),
+		newCommand(2, "PTRACE_PEEKDATA", ptraceArgs...),
+		newCommand(3, "PTRACE_PEEKUSER", ptraceArgs...),
+		newCommand(4, "PTRACE_POKETEXT", ptraceArgs...),
+		newCommand(5, "PTRACE_POKEDATA", ptraceArgs...),
+		newCommand(6, "PTRACE_POKEUSER", ptraceArgs...),
+		withContext(newCommand(7, "PTRACE_CONT", ptraceArgs...), 2, CtxSignal),
+		newCommand(8, "PTRACE_KILL", "long pid"),
+		withContext(newCommand(9, "PTRACE_SINGLESTEP", ptraceArgs...), 2, CtxSignal),
+		newCommand(12, "PTRACE_GETREGS", ptraceArgs...),
+		newCommand(13, "PTRACE_SETREGS", ptraceArgs...),
+		newCommand(14, "PTRACE_GETFPREGS", ptraceArgs...),
+		newCommand(15, "PTRACE_SETFPREGS", ptraceArgs...),
+		newCommand(16, "PTRACE_ATTACH", "long pid"),
+		withContext(newCommand(17, "PTRACE_DETACH", ptraceArgs...), 2, CtxSignal),
+		withContext(newCommand(24, "PTRACE_SYSCALL", ptraceArgs...), 2, CtxSignal),
+		newCommand(0x4200, "PTRACE_SETOPTIONS", ptraceArgs...),
+		newCommand(0x4201, "PTRACE_GETEVENTMSG", ptraceArgs...),
+		newCommand(0x4202, "PTRACE_GETSIGINFO", ptraceArgs...),
+		newCommand(0x4203, "PTRACE_SETSIGINFO", ptraceArgs...),
+		newCommand(0x4204, "PTRACE_GETREGSET", ptraceArgs...),
+		newCommand(0x4205, "PTRACE_SETREGSET", ptraceArgs...),
+		newCommand(0x4206, "PTRACE_SEIZE", ptraceArgs...),
+		newCommand(0x4207, "PTRACE_INTERRUPT", "long pid"),
+		newCommand(0x4208, "PTRACE_LISTEN", "long pid"),
+	)
+	RegisterCommands("ptrace", ptrace)
+
+	bpfArgs := []string{"union bpf_attr *attr", "unsigned int size"}
+	bpf := newCommandSet(0)
+	for val, name := range []string{
+		"BPF_MAP_CREATE", "BPF_MAP_LOOKUP_ELEM", "BPF_MAP_UPDATE_ELEM",
+		"BPF_MAP_DELETE_ELEM", "BPF_MAP_GET_NEXT_KEY", "BPF_PROG_LOAD",
+		"BPF_OBJ_PIN", "BPF_OBJ_GET", "BPF_PROG_ATTACH", "BPF_PROG_DETACH",
+		"BPF_PROG_TEST_RUN", "BPF_PROG_GET_NEXT_ID", "BPF_MAP_GET_NEXT_ID",
+		"BPF_PROG_GET_FD_BY_ID", "BPF_MAP_GET_FD_BY_ID",
+		"BPF_OBJ_GET_INFO_BY_FD", "BPF_PROG_QUERY",
+		"BPF_RAW_TRACEPOINT_OPEN", "BPF_BTF_LOAD", "BPF_BTF_GET_FD_BY_ID",
+		"BPF_TASK_FD_QUERY", "BPF_MAP_LOOKUP_AND_DELETE_ELEM",
+		"BPF_MAP_FREEZE", "BPF_BTF_GET_NEXT_ID", "BPF_MAP_LOOKUP_BATCH",
+		"BPF_MAP_LOOKUP_AND_DELETE_BATCH", "BPF_MAP_UPDATE_BATCH",
+		"BPF_MAP_DELETE_BATCH", "BPF_LINK_CREATE", "BPF_LINK_UPDATE",
+	} {
+		cmd := newCommand(uint64(val), name, bpfArgs...)
+		switch name {
+		case "BPF_MAP_CREATE", "BPF_PROG_LOAD", "BPF_OBJ_GET",
+			"BPF_PROG_GET_FD_BY_ID", "BPF_MAP_GET_FD_BY_ID",
+			"BPF_RAW_TRACEPOINT_OPEN", "BPF_BTF_LOAD",
+			"BPF_BTF_GET_FD_BY_ID", "BPF_LINK_CREATE":
+			cmd.Context = CtxFD
+		}
+		bpf.Commands[cmd.Value] = cmd
+	}
+	RegisterCommands("bpf", bpf)
+
+	RegisterCommands("keyctl", newCommandSet(0,
+		newCommand(0, "KEYCTL_GET_KEYRING_ID", "key_serial_t id", "int create"),
+		newCommand(1, "KEYCTL_JOIN_SESSION_KEYRING", "const char __user *name"),
+		newCommand(2, "KEYCTL_UPDATE", "key_serial_t id", "const void __user *payload", "size_t plen"),
+		newCommand(3, "KEYCTL_REVOKE", "key_serial_t id"),
+		newCommand(4, "KEYCTL_CHOWN", "key_serial_t id", "uid_t uid", "gid_t gid"),
+		newCommand(5, "KEYCTL_SETPERM", "key_serial_t id", "key_perm_t perm"),
+		newCommand(6, "KEYCTL_DESCRIBE", "key_serial_t id", "char __user *buffer", "size_t buflen"),
+		newCommand(7, "KEYCTL_CLEAR", "key_serial_t ringid"),
+		newCommand(8, "KEYCTL_LINK", "key_serial_t id", "key_serial_t ringid"),
+		newCommand(9, "KEYCTL_UNLINK", "key_serial_t id", "key_serial_t ringid"),
+		newCommand(10, "KEYCTL_SEARCH", "key_serial_t ringid", "const char __user *type", "const char __user *description", "key_serial_t destringid"),
+		newCommand(11, "KEYCTL_READ", "key_serial_t id", "char __user *buffer", "size_t buflen"),
+		newCommand(12, "KEYCTL_INSTANTIATE", "key_serial_t id", "const void __user *payload", "size_t plen", "key_serial_t ringid"),
+		newCommand(13, "KEYCTL_NEGATE", "key_serial_t id", "unsigned timeout", "key_serial_t ringid"),
+		newCommand(14, "KEYCTL_SET_REQKEY_KEYRING", "int reqkey_defl"),
+		newCommand(15, "KEYCTL_SET_TIMEOUT", "key_serial_t id", "unsigned timeout"),
+		newCommand(16, "KEYCTL_ASSUME_AUTHORITY", "key_serial_t id"),
+		newCommand(17, "KEYCTL_GET_SECURITY", "key_serial_t id", "char __user *buffer", "size_t buflen"),
+		newCommand(18, "KEYCTL_SESSION_TO_PARENT"),
+		newCommand(19, "KEYCTL_REJECT", "key_serial_t id", "unsigned timeout", "unsigned error", "key_serial_t ringid"),
+		newCommand(20, "KEYCTL_INSTANTIATE_IOV", "key_serial_t id", "const struct iovec __user *iov", "unsigned ioc", "key_serial_t ringid"),
+		newCommand(21, "KEYCTL_INVALIDATE", "key_serial_t id"),
+		newCommand(22, "KEYCTL_GET_PERSISTENT", "uid_t uid", "key_serial_t destringid"),
+	))
+
+	quotaArgs := []string{"const char __user *special", "qid_t id", "void __user *addr"}
+	quotactl := newCommandSet(0,
+		newCommand(0x800001, "Q_SYNC", quotaArgs...),
+		newCommand(0x800002, "Q_QUOTAON", quotaArgs...),
+		newCommand(0x800003, "Q_QUOTAOFF", quotaArgs...),
+		newCommand(0x800004, "Q_GETFMT", quotaArgs...),
+		newCommand(0x800005, "Q_GETINFO", quotaArgs...),
+		newCommand(0x800006, "Q_SETINFO", quotaArgs...),
+		newCommand(0x800007, "Q_GETQUOTA", quotaArgs...),
+		newCommand(0x800008, "Q_SETQUOTA", quotaArgs...),
+		newCommand(0x800009, "Q_GETNEXTQUOTA", quotaArgs...),
+	)
+	quotactl.Shift = 8
+	quotactl.Mask = 0xffffff
+	quotactl.Format = func(name string, rest uint64) string {
+		types := []string{"USRQUOTA", "GRPQUOTA", "PRJQUOTA"}
+		if rest < uint64(len(types)) {
+			return fmt.Sprintf("QCMD(%s, %s)", name, types[rest])
+		}
+		return fmt.Sprintf("QCMD(%s, %#x)", name, rest)
+	}
+	RegisterCommands("quotactl", quotactl)
+}
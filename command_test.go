@@ -0,0 +1,97 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo_test
+
+import (
+	"testing"
+
+	"github.com/jroimartin/syscallinfo"
+	"github.com/jroimartin/syscallinfo/linux_amd64"
+)
+
+var checksCommand = []struct {
+	name   string
+	args   []uint64
+	retval uint64
+	output string
+}{
+	{
+		"fcntl",
+		[]uint64{3, 4, 04000},
+		0,
+		"fcntl(3, F_SETFL, O_NONBLOCK) = 0x00000000",
+	},
+	{
+		"fcntl",
+		[]uint64{3, 3, 0},
+		04002,
+		"fcntl(3, F_GETFL) = O_RDWR|O_NONBLOCK",
+	},
+	{
+		"fcntl",
+		[]uint64{3, 1030, 10},
+		11,
+		"fcntl(3, F_DUPFD_CLOEXEC, 0x0000000a) = 11",
+	},
+	{
+		"fcntl",
+		[]uint64{3, 666, 1},
+		0,
+		"fcntl(3, 0x0000029a, 0x00000001) = 0x00000000",
+	},
+	{
+		"prctl",
+		[]uint64{1, 9, 0, 0, 0},
+		0,
+		"prctl(PR_SET_PDEATHSIG, SIGKILL) = 0x00000000",
+	},
+	{
+		"arch_prctl",
+		[]uint64{0x1002, 0x7f00},
+		0,
+		"arch_prctl(ARCH_SET_FS, 0x00007f00) = 0x00000000",
+	},
+	{
+		"futex",
+		[]uint64{0x1000, 128, 2, 0, 0, 0},
+		0,
+		"futex(0x00001000, FUTEX_WAIT|FUTEX_PRIVATE_FLAG, 0x00000002, 0x00000000) = 0x00000000",
+	},
+	{
+		"ptrace",
+		[]uint64{0, 0, 0, 0},
+		0,
+		"ptrace(PTRACE_TRACEME) = 0x00000000",
+	},
+	{
+		"bpf",
+		[]uint64{0, 0x1000, 72},
+		3,
+		"bpf(BPF_MAP_CREATE, 0x00001000, 0x00000048) = 3",
+	},
+	{
+		"quotactl",
+		[]uint64{0x80000701, 0x1000, 1000, 0x2000},
+		0,
+		"quotactl(QCMD(Q_GETQUOTA, GRPQUOTA), 0x00001000, 0x000003e8, 0x00002000) = 0x00000000",
+	},
+}
+
+func TestSyscallCall_OutputCommand(t *testing.T) {
+	r := syscallinfo.NewResolver(linux_amd64.SyscallTable)
+	for _, check := range checksCommand {
+		sc, err := r.SyscallName(check.name)
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		scc, err := syscallinfo.NewSyscallCall(sc, check.retval, check.args...)
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		if str := scc.String(); str != check.output {
+			t.Errorf("wrong string (want=%v, get=%v)", check.output, str)
+		}
+	}
+}
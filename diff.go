@@ -0,0 +1,136 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+)
+
+// A TableDiff contains the differences between two syscall tables.
+type TableDiff struct {
+	// Added contains the syscalls that are only present in the new table.
+	Added []Syscall
+
+	// Removed contains the syscalls that are only present in the old table.
+	Removed []Syscall
+
+	// Changed contains the syscalls whose name, number or signature
+	// changed, depending on how syscalls were matched.
+	Changed []SyscallChange
+}
+
+// A SyscallChange links two versions of the same syscall.
+type SyscallChange struct {
+	Old Syscall
+	New Syscall
+}
+
+// DiffTables compares the syscall tables old and new. Syscalls are matched by
+// number. The entries of the returned diff are sorted by number.
+func DiffTables(old, new SyscallTable) TableDiff {
+	var d TableDiff
+	for _, n := range tableNums(new) {
+		nsc := new[n]
+		osc, ok := old[n]
+		if !ok {
+			d.Added = append(d.Added, nsc)
+			continue
+		}
+		if osc.Name != nsc.Name || !sameArgs(osc.Args, nsc.Args) {
+			d.Changed = append(d.Changed, SyscallChange{Old: osc, New: nsc})
+		}
+	}
+	for _, n := range tableNums(old) {
+		if _, ok := new[n]; !ok {
+			d.Removed = append(d.Removed, old[n])
+		}
+	}
+	return d
+}
+
+// DiffTablesByName compares the syscall tables old and new matching
+// syscalls by name, which is useful to compare tables of different archs.
+// Syscalls whose number or signature differ are reported as changed. If
+// several syscalls share the same name, the one with the lowest number is
+// used. The entries of the returned diff are sorted by number.
+func DiffTablesByName(old, new SyscallTable) TableDiff {
+	var d TableDiff
+	oldr, newr := NewResolver(old), NewResolver(new)
+	for _, n := range tableNums(new) {
+		nsc := new[n]
+		if lsc, _ := newr.SyscallName(nsc.Name); lsc.Num != n {
+			continue
+		}
+		osc, err := oldr.SyscallName(nsc.Name)
+		if err != nil {
+			d.Added = append(d.Added, nsc)
+			continue
+		}
+		if osc.Num != nsc.Num || !sameArgs(osc.Args, nsc.Args) {
+			d.Changed = append(d.Changed, SyscallChange{Old: osc, New: nsc})
+		}
+	}
+	for _, n := range tableNums(old) {
+		osc := old[n]
+		if lsc, _ := oldr.SyscallName(osc.Name); lsc.Num != n {
+			continue
+		}
+		if !newr.has(osc.Name) {
+			d.Removed = append(d.Removed, osc)
+		}
+	}
+	return d
+}
+
+// Empty reports whether the diff contains no differences.
+func (d TableDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// String returns a line per difference. Added syscalls are prefixed with
+// "+", removed ones with "-" and changed ones with "~". The number of a
+// changed syscall is shown as "old -> new" when it differs.
+func (d TableDiff) String() string {
+	var buf bytes.Buffer
+	for _, sc := range d.Added {
+		fmt.Fprintf(&buf, "+ %d %s\n", sc.Num, sc.Prototype())
+	}
+	for _, sc := range d.Removed {
+		fmt.Fprintf(&buf, "- %d %s\n", sc.Num, sc.Prototype())
+	}
+	for _, c := range d.Changed {
+		if c.Old.Num != c.New.Num {
+			fmt.Fprintf(&buf, "~ %d -> %d %s -> %s\n", c.Old.Num, c.New.Num, c.Old.Prototype(), c.New.Prototype())
+			continue
+		}
+		fmt.Fprintf(&buf, "~ %d %s -> %s\n", c.New.Num, c.Old.Prototype(), c.New.Prototype())
+	}
+	return buf.String()
+}
+
+// tableNums returns the sorted syscall numbers of tbl.
+func tableNums(tbl SyscallTable) []int {
+	nums := make([]int, 0, len(tbl))
+	for n := range tbl {
+		nums = append(nums, n)
+	}
+	sort.Ints(nums)
+	return nums
+}
+
+// sameArgs reports whether a and b have the same signatures.
+func sameArgs(a, b []Argument) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Sig != b[i].Sig || a[i].RefCount != b[i].RefCount {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,103 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo_test
+
+import (
+	"testing"
+
+	"github.com/jroimartin/syscallinfo"
+	_ "github.com/jroimartin/syscallinfo/linux_386"
+	_ "github.com/jroimartin/syscallinfo/linux_amd64"
+)
+
+var (
+	diffOld = syscallinfo.SyscallTable{
+		0: {Num: 0, Name: "read", Args: []syscallinfo.Argument{{Sig: "unsigned int fd"}}},
+		1: {Num: 1, Name: "write", Args: []syscallinfo.Argument{{Sig: "unsigned int fd"}}},
+		2: {Num: 2, Name: "open"},
+	}
+	diffNew = syscallinfo.SyscallTable{
+		0: {Num: 0, Name: "read", Args: []syscallinfo.Argument{{Sig: "unsigned int fd"}}},
+		1: {Num: 1, Name: "write", Args: []syscallinfo.Argument{{Sig: "unsigned int fd"}, {RefCount: 1, Sig: "char *buf"}}},
+		3: {Num: 3, Name: "close", Args: []syscallinfo.Argument{{Sig: "unsigned int fd"}}},
+	}
+	diffWant = "+ 3 close(unsigned int fd)\n" +
+		"- 2 open()\n" +
+		"~ 1 write(unsigned int fd) -> write(unsigned int fd, char *buf)\n"
+)
+
+func TestDiffTables(t *testing.T) {
+	d := syscallinfo.DiffTables(diffOld, diffNew)
+	if get := d.String(); get != diffWant {
+		t.Errorf("wrong diff (want=%q, get=%q)", diffWant, get)
+	}
+	if d := syscallinfo.DiffTables(diffNew, diffNew); !d.Empty() {
+		t.Errorf("wrong diff (want=empty, get=%q)", d.String())
+	}
+}
+
+func TestDiffTablesByName(t *testing.T) {
+	d := syscallinfo.DiffTablesByName(diffOld, diffNew)
+	want := "+ 3 close(unsigned int fd)\n" +
+		"- 2 open()\n" +
+		"~ 1 write(unsigned int fd) -> write(unsigned int fd, char *buf)\n"
+	if get := d.String(); get != want {
+		t.Errorf("wrong diff (want=%q, get=%q)", want, get)
+	}
+
+	moved := syscallinfo.SyscallTable{
+		5: {Num: 5, Name: "read", Args: []syscallinfo.Argument{{Sig: "unsigned int fd"}}},
+	}
+	d = syscallinfo.DiffTablesByName(diffOld, moved)
+	want = "- 1 write(unsigned int fd)\n" +
+		"- 2 open()\n" +
+		"~ 0 -> 5 read(unsigned int fd) -> read(unsigned int fd)\n"
+	if get := d.String(); get != want {
+		t.Errorf("wrong diff (want=%q, get=%q)", want, get)
+	}
+}
+
+var checksSnapshot = []struct {
+	arch    string
+	version string
+	err     bool
+}{
+	{"linux_amd64", "4.0", false},
+	{"linux_386", "4.19", false},
+	{"linux_amd64", "3.19", true},
+	{"linux_arm64", "4.19", true},
+}
+
+func TestNewSnapshotResolver(t *testing.T) {
+	for _, check := range checksSnapshot {
+		v, err := syscallinfo.ParseKernelVersion(check.version)
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		r, err := syscallinfo.NewSnapshotResolver(check.arch, v)
+		if (err != nil) != check.err {
+			t.Errorf("wrong error for %v %v (want=%v, get=%v)", check.arch, v, check.err, err)
+			continue
+		}
+		if check.err {
+			continue
+		}
+		if _, err := r.SyscallName("read"); err != nil {
+			t.Errorf("wrong error (want=nil, get=%v)", err)
+		}
+	}
+}
+
+func TestSnapshots(t *testing.T) {
+	releases := syscallinfo.Snapshots("linux_amd64")
+	if len(releases) == 0 {
+		t.Fatal("no snapshots registered for linux_amd64")
+	}
+	for i := 1; i < len(releases); i++ {
+		if !releases[i-1].Less(releases[i]) {
+			t.Errorf("snapshots not sorted (%v after %v)", releases[i], releases[i-1])
+		}
+	}
+}
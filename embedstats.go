@@ -0,0 +1,169 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ignore
+// +build ignore
+
+// embedstats compares the default build of the table packages with the
+// syscallinfo_embed build. It builds a program that imports every table
+// package in both modes and reports the size of the binaries and the init
+// time of the table packages, as reported by GODEBUG=inittrace=1.
+//
+// Usage:
+//
+//	go run embedstats.go [-n runs]
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+var runs = flag.Int("n", 20, "number of runs used to compute the init time")
+
+const pkgPath = "github.com/jroimartin/syscallinfo"
+
+// tablePkgs are the table packages imported by the test program.
+var tablePkgs = []string{
+	"linux_386",
+	"linux_amd64",
+	"linux_arm",
+	"linux_arm64",
+	"linux_riscv64",
+}
+
+const progTemplate = `package main
+
+import (
+	"fmt"
+
+	"github.com/jroimartin/syscallinfo"
+%s)
+
+func main() {
+	fmt.Println(syscallinfo.Arches())
+}
+`
+
+// inittraceRE matches the lines printed by GODEBUG=inittrace=1.
+var inittraceRE = regexp.MustCompile(`^init (\S+) @\S+ ms, ([\d.]+) ms clock, (\d+) bytes, (\d+) allocs$`)
+
+type stats struct {
+	size   int64
+	clock  float64 // Median init time of the table packages in ms.
+	bytes  int     // Bytes allocated by the init of the table packages.
+	allocs int     // Allocations made by the init of the table packages.
+}
+
+func main() {
+	flag.Parse()
+	if *runs < 1 {
+		log.Fatalln("the number of runs must be positive")
+	}
+
+	dir, err := ioutil.TempDir("", "embedstats")
+	if err != nil {
+		log.Fatalln(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var imports string
+	for _, p := range tablePkgs {
+		imports += fmt.Sprintf("\t_ %q\n", pkgPath+"/"+p)
+	}
+	src := filepath.Join(dir, "main.go")
+	if err := ioutil.WriteFile(src, []byte(fmt.Sprintf(progTemplate, imports)), 0644); err != nil {
+		log.Fatalln(err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "mode\tbinary size (bytes)\tinit time (ms)\tinit bytes\tinit allocs\t")
+	for _, mode := range []string{"literal", "embed"} {
+		bin := filepath.Join(dir, mode)
+		st, err := measure(src, bin, mode == "embed")
+		if err != nil {
+			log.Fatalln(err)
+		}
+		fmt.Fprintf(w, "%v\t%v\t%.3f\t%v\t%v\t\n", mode, st.size, st.clock, st.bytes, st.allocs)
+	}
+	w.Flush()
+}
+
+// measure builds src into bin and runs it to obtain the stats of the table
+// packages.
+func measure(src, bin string, embed bool) (stats, error) {
+	args := []string{"build", "-o", bin}
+	if embed {
+		args = append(args, "-tags", "syscallinfo_embed")
+	}
+	cmd := exec.Command("go", append(args, src)...)
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return stats{}, err
+	}
+	fi, err := os.Stat(bin)
+	if err != nil {
+		return stats{}, err
+	}
+
+	st := stats{size: fi.Size()}
+	var clocks []float64
+	for i := 0; i < *runs; i++ {
+		var stderr bytes.Buffer
+		cmd := exec.Command(bin)
+		cmd.Env = append(os.Environ(), "GODEBUG=inittrace=1")
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			return stats{}, err
+		}
+		var clock float64
+		st.bytes, st.allocs = 0, 0
+		s := bufio.NewScanner(&stderr)
+		for s.Scan() {
+			m := inittraceRE.FindStringSubmatch(s.Text())
+			if m == nil || !isTablePkg(m[1]) {
+				continue
+			}
+			c, _ := strconv.ParseFloat(m[2], 64)
+			b, _ := strconv.Atoi(m[3])
+			a, _ := strconv.Atoi(m[4])
+			clock += c
+			st.bytes += b
+			st.allocs += a
+		}
+		clocks = append(clocks, clock)
+	}
+	st.clock = median(clocks)
+	return st, nil
+}
+
+func isTablePkg(path string) bool {
+	for _, p := range tablePkgs {
+		if strings.HasSuffix(path, "/"+p) {
+			return true
+		}
+	}
+	return false
+}
+
+func median(vs []float64) float64 {
+	sort.Float64s(vs)
+	n := len(vs)
+	if n%2 == 1 {
+		return vs[n/2]
+	}
+	return (vs[n/2-1] + vs[n/2]) / 2
+}
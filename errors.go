@@ -0,0 +1,177 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrMemoryRequired is returned when decoding a value requires reading the
+// memory of the traced process but no memory reader was provided.
+var ErrMemoryRequired = errors.New("memory reader required")
+
+// ErrNotSyscall is returned when a syscall call is requested from a trace
+// record that does not describe one (e.g. a signal).
+var ErrNotSyscall = errors.New("trace record is not a syscall call")
+
+// ErrInvalidTable is returned when the binary encoding of a syscall table
+// cannot be decoded.
+var ErrInvalidTable = errors.New("invalid binary syscall table")
+
+// An UnknownSyscallError is returned when a syscall cannot be found in a
+// syscall table. Only the field used in the lookup (Num, Name or Entry) is
+// set. Arch is set if the table is a registered one.
+type UnknownSyscallError struct {
+	Num   int
+	Name  string
+	Entry string
+	Arch  string
+}
+
+func (e *UnknownSyscallError) Error() string {
+	var s string
+	switch {
+	case e.Name != "":
+		s = fmt.Sprintf("unknown syscall %q", e.Name)
+	case e.Entry != "":
+		s = fmt.Sprintf("unknown syscall entry %q", e.Entry)
+	default:
+		s = fmt.Sprintf("unknown syscall %d", e.Num)
+	}
+	if e.Arch != "" {
+		s += " (" + e.Arch + ")"
+	}
+	return s
+}
+
+// An ArgCountError is returned when the number of arguments provided to a
+// syscall is lower than the number of arguments it requires.
+type ArgCountError struct {
+	Want int
+	Got  int
+}
+
+func (e *ArgCountError) Error() string {
+	return fmt.Sprintf("invalid number of arguments (want=%d, got=%d)", e.Want, e.Got)
+}
+
+// An UnknownArchError is returned when an arch, or a snapshot of it, is not
+// registered. Release is only set for snapshots.
+type UnknownArchError struct {
+	Arch    string
+	Release KernelVersion
+}
+
+func (e *UnknownArchError) Error() string {
+	if !e.Release.IsZero() {
+		return fmt.Sprintf("no snapshot of arch %q for kernel %v", e.Arch, e.Release)
+	}
+	return fmt.Sprintf("unknown arch %q", e.Arch)
+}
+
+// A NotMultiplexedError is returned when a sub-call is requested for a
+// syscall that is not a multiplexer.
+type NotMultiplexedError struct {
+	Syscall string
+}
+
+func (e *NotMultiplexedError) Error() string {
+	return fmt.Sprintf("%s is not multiplexed", e.Syscall)
+}
+
+// A SubcallRequiredError is returned when a multiplexer is used where a
+// sub-call is required.
+type SubcallRequiredError struct {
+	Syscall string
+}
+
+func (e *SubcallRequiredError) Error() string {
+	return fmt.Sprintf("%s is multiplexed, a sub-call is required", e.Syscall)
+}
+
+// An UnknownSubcallError is returned when the sub-call of a multiplexer is
+// not known or not supported.
+type UnknownSubcallError struct {
+	Syscall string
+	Call    int
+}
+
+func (e *UnknownSubcallError) Error() string {
+	return fmt.Sprintf("unknown %s sub-call %d", e.Syscall, e.Call)
+}
+
+// An UnsupportedVersionError is returned when a multiplexed sub-call uses a
+// calling convention version that is not supported (e.g. the iBCS2 version
+// of shmat).
+type UnsupportedVersionError struct {
+	Syscall string
+	Subcall string
+	Version int
+}
+
+func (e *UnsupportedVersionError) Error() string {
+	return fmt.Sprintf("unsupported version %d of %s sub-call %s", e.Version, e.Syscall, e.Subcall)
+}
+
+// A NoEquivalentError is returned when a syscall cannot be translated to
+// the target table.
+type NoEquivalentError struct {
+	Name string
+}
+
+func (e *NoEquivalentError) Error() string {
+	return fmt.Sprintf("no equivalent for %s", e.Name)
+}
+
+// A NotImplementedError is returned when a syscall is present in a table
+// but it is not implemented.
+type NotImplementedError struct {
+	Num  int
+	Name string
+}
+
+func (e *NotImplementedError) Error() string {
+	return fmt.Sprintf("%s (%d) is not implemented", e.Name, e.Num)
+}
+
+// A ParseError is returned when a value cannot be parsed. Kind describes
+// what was being parsed (e.g. "signal" or "kernel version").
+type ParseError struct {
+	Kind  string
+	Value string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("invalid %s %q", e.Kind, e.Value)
+}
+
+// A FilterError is returned when a filter expression cannot be parsed. Err
+// is the underlying error.
+type FilterError struct {
+	Expr string
+	Err  error
+}
+
+func (e *FilterError) Error() string {
+	return e.Expr + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *FilterError) Unwrap() error {
+	return e.Err
+}
+
+// A TraceSyntaxError is returned when a line of a syscall tracer log cannot
+// be parsed. Format is the name of the log format (e.g. "strace").
+type TraceSyntaxError struct {
+	Format string
+	Line   int
+	Text   string
+}
+
+func (e *TraceSyntaxError) Error() string {
+	return fmt.Sprintf("%s: line %d: invalid syntax: %q", e.Format, e.Line, e.Text)
+}
@@ -0,0 +1,117 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jroimartin/syscallinfo"
+	_ "github.com/jroimartin/syscallinfo/linux_386"
+	"github.com/jroimartin/syscallinfo/linux_amd64"
+)
+
+func TestUnknownSyscallError(t *testing.T) {
+	r, err := syscallinfo.NewArchResolver("linux_amd64")
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	_, err = r.SyscallN(1000)
+	var uerr *syscallinfo.UnknownSyscallError
+	if !errors.As(err, &uerr) {
+		t.Fatalf("wrong error type (want=*UnknownSyscallError, get=%T)", err)
+	}
+	if uerr.Num != 1000 || uerr.Arch != "linux_amd64" {
+		t.Errorf("wrong error fields (want=1000/linux_amd64, get=%v/%v)", uerr.Num, uerr.Arch)
+	}
+	if want := "unknown syscall 1000 (linux_amd64)"; err.Error() != want {
+		t.Errorf("wrong message (want=%q, get=%q)", want, err.Error())
+	}
+
+	_, err = r.SyscallName("foo")
+	if !errors.As(err, &uerr) || uerr.Name != "foo" {
+		t.Errorf("wrong error (want=unknown syscall foo, get=%v)", err)
+	}
+	_, err = r.SyscallEntry("sys_foo")
+	if !errors.As(err, &uerr) || uerr.Entry != "sys_foo" {
+		t.Errorf("wrong error (want=unknown entry sys_foo, get=%v)", err)
+	}
+}
+
+func TestArgCountError(t *testing.T) {
+	sc := linux_amd64.SyscallTable[0]
+	_, err := syscallinfo.NewSyscallCall(sc, 0, 1)
+	var aerr *syscallinfo.ArgCountError
+	if !errors.As(err, &aerr) {
+		t.Fatalf("wrong error type (want=*ArgCountError, get=%T)", err)
+	}
+	if aerr.Want != 3 || aerr.Got != 1 {
+		t.Errorf("wrong error fields (want=3/1, get=%v/%v)", aerr.Want, aerr.Got)
+	}
+}
+
+func TestErrorTypes(t *testing.T) {
+	var (
+		archErr   *syscallinfo.UnknownArchError
+		parseErr  *syscallinfo.ParseError
+		filterErr *syscallinfo.FilterError
+		subErr    *syscallinfo.UnknownSubcallError
+		equivErr  *syscallinfo.NoEquivalentError
+	)
+
+	_, err := syscallinfo.Table("linux_foo")
+	if !errors.As(err, &archErr) || archErr.Arch != "linux_foo" {
+		t.Errorf("wrong error (want=UnknownArchError, get=%v)", err)
+	}
+
+	_, err = syscallinfo.ParseSignal("SIGFOO")
+	if !errors.As(err, &parseErr) || parseErr.Kind != "signal" {
+		t.Errorf("wrong error (want=ParseError, get=%v)", err)
+	}
+
+	r := syscallinfo.NewResolver(linux_amd64.SyscallTable)
+	_, err = syscallinfo.ParseFilter(r, "trace=%foo")
+	if !errors.As(err, &filterErr) || !errors.As(err, &parseErr) || parseErr.Value != "foo" {
+		t.Errorf("wrong error (want=FilterError wrapping ParseError, get=%v)", err)
+	}
+
+	tr, err := syscallinfo.NewArchTranslator("linux_386", "linux_amd64")
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	_, err = tr.TranslateSubcall(102, 100)
+	if !errors.As(err, &subErr) || subErr.Syscall != "socketcall" || subErr.Call != 100 {
+		t.Errorf("wrong error (want=UnknownSubcallError, get=%v)", err)
+	}
+	_, err = tr.Translate(113)
+	if !errors.As(err, &equivErr) || equivErr.Name != "vm86old" {
+		t.Errorf("wrong error (want=NoEquivalentError, get=%v)", err)
+	}
+
+	sc := syscallinfo.Syscall{Name: "socketcall", Args: make([]syscallinfo.Argument, 2)}
+	scc, err := syscallinfo.NewSyscallCall(sc, 0, 1, 0x1000)
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	if _, err := syscallinfo.Demultiplex(scc, nil); !errors.Is(err, syscallinfo.ErrMemoryRequired) {
+		t.Errorf("wrong error (want=%v, get=%v)", syscallinfo.ErrMemoryRequired, err)
+	}
+
+	var verErr *syscallinfo.UnsupportedVersionError
+	sc = syscallinfo.Syscall{Name: "ipc", Args: make([]syscallinfo.Argument, 6)}
+	scc, err = syscallinfo.NewSyscallCall(sc, 0, 0x10015, 7, 0, 0, 0x5000, 0)
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	_, err = syscallinfo.Demultiplex(scc, nil)
+	if !errors.As(err, &verErr) || verErr.Subcall != "shmat" || verErr.Version != 1 {
+		t.Errorf("wrong error (want=UnsupportedVersionError, get=%v)", err)
+	}
+
+	_, err = syscallinfo.ParseFilter(r, "trace=")
+	if !errors.As(err, &parseErr) || parseErr.Kind != "trace value" {
+		t.Errorf("wrong error (want=ParseError for trace value, get=%v)", err)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package export
+
+import (
+	"io"
+
+	"github.com/jroimartin/syscallinfo"
+)
+
+// WriteC writes tbl as a C header. The header defines a
+// SYSCALLINFO_<NAME>_NR_* macro per syscall name (e.g.
+// SYSCALLINFO_LINUX_386_NR_read) and an array called syscallinfo_<name> with
+// the metadata of every syscall. name identifies the table (e.g.
+// "linux_amd64"). The macros are prefixed so that the header can be included
+// along with <sys/syscall.h>, whose __NR_* macros are the ones of the host.
+func WriteC(w io.Writer, name string, tbl syscallinfo.SyscallTable) error {
+	return execute(w, cTemplate, name, tbl)
+}
+
+const cTemplate = `/* {{.Header}} */
+
+#ifndef SYSCALLINFO_{{upper (ident .Name)}}_H
+#define SYSCALLINFO_{{upper (ident .Name)}}_H
+
+{{range .Defines}}#define SYSCALLINFO_{{upper (ident $.Name)}}_NR_{{ident .Name}} {{.Num}}
+{{end}}
+#ifndef SYSCALLINFO_TYPES
+#define SYSCALLINFO_TYPES
+
+struct syscallinfo_arg {
+	const char *sig;
+	int refcount;
+	const char *context;
+};
+
+struct syscallinfo_syscall {
+	int num;
+	const char *name;
+	const char *entry;
+	const char *context;
+	const char *status;
+	const char *categories;
+	int nargs;
+	struct syscallinfo_arg args[6];
+};
+
+#endif /* SYSCALLINFO_TYPES */
+
+static const struct syscallinfo_syscall syscallinfo_{{ident .Name}}[] = {
+{{range .Syscalls}}	{ {{- .Num}}, {{quote .Name}}, {{quote .Entry}}, {{quote (context .Context)}}, {{quote (status .Status)}}, {{quote (.Categories.String)}}, {{len .Args}}, {
+{{- range $i, $a := .Args}}{{if $i}}, {{end}}{ {{- quote .Sig}}, {{.RefCount}}, {{quote (context .Context)}}}{{else}}{0}{{end -}}
+}},
+{{end}}};
+
+#endif /* SYSCALLINFO_{{upper (ident .Name)}}_H */
+`
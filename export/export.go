@@ -0,0 +1,86 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package export writes syscall tables in formats usable from other
+// languages and tools.
+package export
+
+import (
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/jroimartin/syscallinfo"
+)
+
+// header is the first line of the generated files, without the comment
+// delimiters.
+const header = "MACHINE GENERATED BY syscallinfo; DO NOT EDIT"
+
+// A table is the view of a syscall table used by the templates.
+type table struct {
+	// Header is the first line of the file.
+	Header string
+
+	// Name is the name of the table (e.g. "linux_amd64").
+	Name string
+
+	// Syscalls contains the syscalls of the table sorted by number.
+	Syscalls []syscallinfo.Syscall
+
+	// Defines contains the syscalls that get a named constant. If several
+	// syscalls share the same name, only the one with the lowest number is
+	// included.
+	Defines []syscallinfo.Syscall
+}
+
+func newTable(name string, tbl syscallinfo.SyscallTable) table {
+	t := table{Header: header, Name: name}
+	for _, sc := range tbl {
+		t.Syscalls = append(t.Syscalls, sc)
+	}
+	sort.Slice(t.Syscalls, func(i, j int) bool {
+		return t.Syscalls[i].Num < t.Syscalls[j].Num
+	})
+	seen := map[string]bool{}
+	for _, sc := range t.Syscalls {
+		if !seen[sc.Name] {
+			t.Defines = append(t.Defines, sc)
+			seen[sc.Name] = true
+		}
+	}
+	return t
+}
+
+// funcs contains the functions shared by the templates.
+var funcs = template.FuncMap{
+	"quote":   strconv.Quote,
+	"upper":   strings.ToUpper,
+	"ident":   ident,
+	"context": syscallinfo.Context.String,
+	"status":  syscallinfo.SyscallStatus.String,
+	"cats":    func(cat syscallinfo.Category) []string { return cat.Names() },
+}
+
+// ident returns s with the characters that are not valid in identifiers
+// replaced by "_".
+func ident(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' {
+			return r
+		}
+		return '_'
+	}, s)
+}
+
+// execute renders tbl with the template text.
+func execute(w io.Writer, text, name string, tbl syscallinfo.SyscallTable) error {
+	t, err := template.New(name).Funcs(funcs).Parse(text)
+	if err != nil {
+		return err
+	}
+	return t.Execute(w, newTable(name, tbl))
+}
@@ -0,0 +1,94 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package export_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/jroimartin/syscallinfo"
+	"github.com/jroimartin/syscallinfo/export"
+)
+
+var exportTable = syscallinfo.SyscallTable{
+	3: {
+		Num:   3,
+		Name:  "read",
+		Entry: "sys_read",
+		Args: []syscallinfo.Argument{
+			{Sig: "unsigned int fd", Context: syscallinfo.CtxFD},
+			{RefCount: 1, Sig: "char __user *buf"},
+			{Sig: "size_t count"},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	17:  {Num: 17, Name: "break", Entry: "sys_ni_syscall", Status: syscallinfo.SyscallNotImplemented},
+	514: {Num: 514, Name: "read", Entry: "compat_sys_read"},
+}
+
+var checksExport = []struct {
+	name  string
+	write func(w io.Writer, name string, tbl syscallinfo.SyscallTable) error
+	want  []string
+	not   []string
+}{
+	{
+		"c",
+		export.WriteC,
+		[]string{
+			"#ifndef SYSCALLINFO_LINUX_TEST_H",
+			"#define SYSCALLINFO_LINUX_TEST_NR_read 3\n",
+			"#define SYSCALLINFO_LINUX_TEST_NR_break 17\n",
+			`{3, "read", "sys_read", "", "", "desc", 3, {{"unsigned int fd", 0, "FD"}, {"char __user *buf", 1, ""}, {"size_t count", 0, ""}}},`,
+			`{17, "break", "sys_ni_syscall", "", "NOT_IMPLEMENTED", "", 0, {{0}}},`,
+			`{514, "read", "compat_sys_read"`,
+		},
+		[]string{"_NR_read 514", "__NR_"},
+	},
+	{
+		"rust",
+		export.WriteRust,
+		[]string{
+			"pub const SYS_READ: u32 = 3;",
+			`Arg { sig: "unsigned int fd", refcount: 0, context: "FD" },`,
+			`categories: &["desc"],`,
+			`status: "NOT_IMPLEMENTED",`,
+		},
+		[]string{"SYS_READ: u32 = 514"},
+	},
+	{
+		"python",
+		export.WritePython,
+		[]string{
+			"SYS_READ = 3\n",
+			`3: Syscall(3, "read", "sys_read", "", "", ("desc", ), (`,
+			`Arg("char __user *buf", 1, ""),`,
+			`514: Syscall(514, "read", "compat_sys_read"`,
+		},
+		[]string{"SYS_READ = 514"},
+	},
+}
+
+func TestWrite(t *testing.T) {
+	for _, check := range checksExport {
+		var buf bytes.Buffer
+		if err := check.write(&buf, "linux_test", exportTable); err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		out := buf.String()
+		for _, want := range check.want {
+			if !strings.Contains(out, want) {
+				t.Errorf("%v: missing %q", check.name, want)
+			}
+		}
+		for _, not := range check.not {
+			if strings.Contains(out, not) {
+				t.Errorf("%v: unexpected %q", check.name, not)
+			}
+		}
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package export
+
+import (
+	"io"
+
+	"github.com/jroimartin/syscallinfo"
+)
+
+// WritePython writes tbl as a Python module. The module defines a SYS_*
+// constant per syscall name and a SYSCALLS dict, indexed by number, with
+// the metadata of every syscall. name identifies the table (e.g.
+// "linux_amd64").
+func WritePython(w io.Writer, name string, tbl syscallinfo.SyscallTable) error {
+	return execute(w, pythonTemplate, name, tbl)
+}
+
+const pythonTemplate = `# {{.Header}}
+
+"""Syscall table {{.Name}}."""
+
+from collections import namedtuple
+
+Arg = namedtuple('Arg', ['sig', 'refcount', 'context'])
+Syscall = namedtuple('Syscall', ['num', 'name', 'entry', 'context', 'status', 'categories', 'args'])
+
+{{range .Defines}}SYS_{{upper (ident .Name)}} = {{.Num}}
+{{end}}
+SYSCALLS = {
+{{range .Syscalls}}    {{.Num}}: Syscall({{.Num}}, {{quote .Name}}, {{quote .Entry}}, {{quote (context .Context)}}, {{quote (status .Status)}}, ({{range cats .Categories}}{{quote .}}, {{end}}), ({{range .Args}}
+        Arg({{quote .Sig}}, {{.RefCount}}, {{quote (context .Context)}}),{{end}}
+    )),
+{{end}}}
+`
@@ -0,0 +1,181 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package export
+
+import (
+	htmltemplate "html/template"
+	"io"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/jroimartin/syscallinfo"
+)
+
+// A NamedTable is a syscall table with its name (e.g. "linux_386").
+type NamedTable struct {
+	Name  string
+	Table syscallinfo.SyscallTable
+}
+
+// A reference is the view of a syscall table used by the reference page
+// templates.
+type reference struct {
+	Header string
+	Name   string
+	Others []string
+	Rows   []referenceRow
+}
+
+// A referenceRow contains the formatted fields of a syscall.
+type referenceRow struct {
+	Num        int
+	Name       string
+	Entry      string
+	Args       []string
+	Return     string
+	Categories string
+	Kernel     string
+	Status     string
+	Others     []string
+}
+
+func newReference(name string, tbl syscallinfo.SyscallTable, others []NamedTable) reference {
+	ref := reference{Header: header, Name: name}
+	var trs []*syscallinfo.Translator
+	for _, o := range others {
+		ref.Others = append(ref.Others, o.Name)
+		trs = append(trs, syscallinfo.NewTranslator(tbl, o.Table))
+	}
+	for _, sc := range newTable(name, tbl).Syscalls {
+		row := referenceRow{
+			Num:        sc.Num,
+			Name:       sc.Name,
+			Entry:      sc.Entry,
+			Return:     sc.Context.String(),
+			Categories: strings.Join(sc.Categories.Names(), ", "),
+			Kernel:     kernelRange(sc),
+			Status:     sc.Status.String(),
+		}
+		for _, arg := range sc.Args {
+			s := arg.Sig
+			if arg.Context != syscallinfo.CtxNone {
+				s += " (" + arg.Context.String() + ")"
+			}
+			row.Args = append(row.Args, s)
+		}
+		for _, tr := range trs {
+			row.Others = append(row.Others, equivalent(tr, sc))
+		}
+		ref.Rows = append(ref.Rows, row)
+	}
+	return ref
+}
+
+// kernelRange returns the kernel versions in which sc is available (e.g.
+// "2.6.16", "2.6.0-5.5" or "-5.5" if it predates 2.6.0). It is empty if they
+// are not known.
+func kernelRange(sc syscallinfo.Syscall) string {
+	switch {
+	case sc.Since.IsZero() && sc.Until.IsZero():
+		return ""
+	case sc.Until.IsZero():
+		return sc.Since.String()
+	case sc.Since.IsZero():
+		return "-" + sc.Until.String()
+	}
+	return sc.Since.String() + "-" + sc.Until.String()
+}
+
+// equivalent returns the number of the syscall equivalent to sc in the
+// target table of tr. If it is reached through a multiplexer, the sub-call
+// is included (e.g. "102 (socketcall 3)"). If there is no equivalent, it
+// returns an empty string.
+func equivalent(tr *syscallinfo.Translator, sc syscallinfo.Syscall) string {
+	if sc.Status == syscallinfo.SyscallNotImplemented {
+		return ""
+	}
+	trn, err := tr.TranslateName(sc.Name)
+	if err != nil {
+		return ""
+	}
+	s := strconv.Itoa(trn.Syscall.Num)
+	if trn.Call >= 0 {
+		s += " (" + trn.Syscall.Name + " " + strconv.Itoa(trn.Call) + ")"
+	} else if trn.Syscall.Name != sc.Name {
+		s += " (" + trn.Syscall.Name + ")"
+	}
+	return s
+}
+
+// WriteMarkdown writes tbl as a Markdown reference page. For every syscall,
+// the number of its equivalent in each one of the others tables is shown.
+func WriteMarkdown(w io.Writer, name string, tbl syscallinfo.SyscallTable, others ...NamedTable) error {
+	t, err := template.New("markdown").Funcs(template.FuncMap{"join": strings.Join}).Parse(markdownTemplate)
+	if err != nil {
+		return err
+	}
+	return t.Execute(w, newReference(name, tbl, others))
+}
+
+const markdownTemplate = `<!-- {{.Header}} -->
+
+# Syscall table {{.Name}}
+
+| Num | Name | Entry | Arguments | Return | Categories | Kernel | Status |{{range .Others}} {{.}} |{{end}}
+|----:|------|-------|-----------|--------|------------|--------|--------|{{range .Others}}----:|{{end}}
+{{range .Rows}}| {{.Num}} | {{.Name}} | {{.Entry}} | {{range $i, $a := .Args}}{{if $i}}, {{end}}` + "`{{$a}}`" + `{{end}} | {{.Return}} | {{.Categories}} | {{.Kernel}} | {{.Status}} |{{range .Others}} {{.}} |{{end}}
+{{end}}`
+
+// WriteHTML writes tbl as a self-contained HTML reference page. For every
+// syscall, the number of its equivalent in each one of the others tables is
+// shown.
+func WriteHTML(w io.Writer, name string, tbl syscallinfo.SyscallTable, others ...NamedTable) error {
+	t, err := htmltemplate.New("html").Parse(htmlTemplate)
+	if err != nil {
+		return err
+	}
+	return t.Execute(w, newReference(name, tbl, others))
+}
+
+const htmlTemplate = `<!DOCTYPE html>
+<!-- {{.Header}} -->
+<html>
+<head>
+<meta charset="utf-8">
+<title>Syscall table {{.Name}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 0.3em 0.6em; text-align: left; vertical-align: top; }
+th { background: #eee; position: sticky; top: 0; }
+td.num { text-align: right; }
+code { white-space: nowrap; }
+tr.ni { color: #999; }
+</style>
+</head>
+<body>
+<h1>Syscall table {{.Name}}</h1>
+<p><input id="filter" type="search" placeholder="Filter syscalls" oninput="filter(this.value)"></p>
+<table id="syscalls">
+<thead>
+<tr><th>Num</th><th>Name</th><th>Entry</th><th>Arguments</th><th>Return</th><th>Categories</th><th>Kernel</th><th>Status</th>{{range .Others}}<th>{{.}}</th>{{end}}</tr>
+</thead>
+<tbody>
+{{range .Rows}}<tr id="{{.Name}}-{{.Num}}"{{if eq .Status "NOT_IMPLEMENTED"}} class="ni"{{end}}><td class="num">{{.Num}}</td><td>{{.Name}}</td><td>{{.Entry}}</td><td>{{range $i, $a := .Args}}{{if $i}}<br>{{end}}<code>{{$a}}</code>{{end}}</td><td>{{.Return}}</td><td>{{.Categories}}</td><td>{{.Kernel}}</td><td>{{.Status}}</td>{{range .Others}}<td class="num">{{.}}</td>{{end}}</tr>
+{{end}}</tbody>
+</table>
+<script>
+function filter(s) {
+	s = s.toLowerCase();
+	var rows = document.getElementById("syscalls").tBodies[0].rows;
+	for (var i = 0; i < rows.length; i++) {
+		rows[i].style.display = rows[i].textContent.toLowerCase().indexOf(s) >= 0 ? "" : "none";
+	}
+}
+</script>
+</body>
+</html>
+`
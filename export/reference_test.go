@@ -0,0 +1,75 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package export_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/jroimartin/syscallinfo"
+	"github.com/jroimartin/syscallinfo/export"
+)
+
+var referenceOthers = []export.NamedTable{
+	{
+		Name: "linux_other",
+		Table: syscallinfo.SyscallTable{
+			0: {Num: 0, Name: "read", Entry: "sys_read"},
+		},
+	},
+}
+
+var checksReference = []struct {
+	name  string
+	write func(w io.Writer, name string, tbl syscallinfo.SyscallTable, others ...export.NamedTable) error
+	want  []string
+	not   []string
+}{
+	{
+		"markdown",
+		export.WriteMarkdown,
+		[]string{
+			"# Syscall table linux_test\n",
+			"| Status | linux_other |\n",
+			"| 3 | read | sys_read | `unsigned int fd (FD)`, `char __user *buf`, `size_t count` |  | desc |  |  | 0 |\n",
+			"| 17 | break | sys_ni_syscall |  |  |  |  | NOT_IMPLEMENTED |  |\n",
+			"| 514 | read | compat_sys_read |  |  |  |  |  | 0 |\n",
+		},
+		nil,
+	},
+	{
+		"html",
+		export.WriteHTML,
+		[]string{
+			"<title>Syscall table linux_test</title>",
+			"<th>linux_other</th>",
+			`<tr id="read-3"><td class="num">3</td><td>read</td><td>sys_read</td><td><code>unsigned int fd (FD)</code><br><code>char __user *buf</code><br>`,
+			`<tr id="break-17" class="ni">`,
+		},
+		[]string{"<script src="},
+	},
+}
+
+func TestWriteReference(t *testing.T) {
+	for _, check := range checksReference {
+		var buf bytes.Buffer
+		if err := check.write(&buf, "linux_test", exportTable, referenceOthers...); err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		out := buf.String()
+		for _, want := range check.want {
+			if !strings.Contains(out, want) {
+				t.Errorf("%v: missing %q", check.name, want)
+			}
+		}
+		for _, not := range check.not {
+			if strings.Contains(out, not) {
+				t.Errorf("%v: unexpected %q", check.name, not)
+			}
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package export
+
+import (
+	"io"
+
+	"github.com/jroimartin/syscallinfo"
+)
+
+// WriteRust writes tbl as a Rust module. The module defines a SYS_* constant
+// per syscall name and a SYSCALLS slice with the metadata of every syscall.
+// name identifies the table (e.g. "linux_amd64").
+func WriteRust(w io.Writer, name string, tbl syscallinfo.SyscallTable) error {
+	return execute(w, rustTemplate, name, tbl)
+}
+
+const rustTemplate = `// {{.Header}}
+
+//! Syscall table {{.Name}}.
+
+#![allow(dead_code)]
+
+/// A syscall argument.
+#[derive(Debug, Clone, Copy, PartialEq, Eq)]
+pub struct Arg {
+    pub sig: &'static str,
+    pub refcount: u32,
+    pub context: &'static str,
+}
+
+/// A syscall.
+#[derive(Debug, Clone, Copy, PartialEq, Eq)]
+pub struct Syscall {
+    pub num: u32,
+    pub name: &'static str,
+    pub entry: &'static str,
+    pub context: &'static str,
+    pub status: &'static str,
+    pub categories: &'static [&'static str],
+    pub args: &'static [Arg],
+}
+
+{{range .Defines}}pub const SYS_{{upper (ident .Name)}}: u32 = {{.Num}};
+{{end}}
+pub static SYSCALLS: &[Syscall] = &[
+{{range .Syscalls}}    Syscall {
+        num: {{.Num}},
+        name: {{quote .Name}},
+        entry: {{quote .Entry}},
+        context: {{quote (context .Context)}},
+        status: {{quote (status .Status)}},
+        categories: &[{{range $i, $c := cats .Categories}}{{if $i}}, {{end}}{{quote $c}}{{end}}],
+        args: &[{{range .Args}}
+            Arg { sig: {{quote .Sig}}, refcount: {{.RefCount}}, context: {{quote (context .Context)}} },{{end}}
+        ],
+    },
+{{end}}];
+`
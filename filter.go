@@ -0,0 +1,292 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo
+
+import (
+	"regexp"
+	"strings"
+)
+
+// CallStatus represents the possible outcomes of a syscall call, as used by
+// strace's status qualifier.
+type CallStatus int
+
+const (
+	// StatusSuccessful represents calls that returned without error.
+	StatusSuccessful CallStatus = 1 << iota
+	// StatusFailed represents calls that returned an error.
+	StatusFailed
+	// StatusUnfinished represents calls that did not return.
+	StatusUnfinished
+	// StatusUnavailable represents calls whose return value is unknown.
+	StatusUnavailable
+	// StatusDetached represents calls that were being executed when the
+	// tracer detached.
+	StatusDetached
+)
+
+var statusNames = map[string]CallStatus{
+	"successful":  StatusSuccessful,
+	"failed":      StatusFailed,
+	"unfinished":  StatusUnfinished,
+	"unavailable": StatusUnavailable,
+	"detached":    StatusDetached,
+}
+
+const statusAll = StatusSuccessful | StatusFailed | StatusUnfinished |
+	StatusUnavailable | StatusDetached
+
+// A Filter selects syscalls, calls and signals using the expression syntax
+// of strace's -e option. A new Filter matches everything.
+type Filter struct {
+	r       Resolver
+	trace   map[int]bool
+	signals map[int]bool
+	status  CallStatus
+}
+
+// NewFilter returns a Filter that resolves syscall names using r.
+func NewFilter(r Resolver) *Filter {
+	return &Filter{r: r, status: statusAll}
+}
+
+// ParseFilter returns a Filter configured with the provided expressions.
+func ParseFilter(r Resolver, exprs ...string) (*Filter, error) {
+	f := NewFilter(r)
+	for _, expr := range exprs {
+		if err := f.Parse(expr); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// Parse applies the expression expr to the filter. Its syntax is
+// "qualifier=value,...", where qualifier is one of trace, signal or status.
+// If the qualifier is omitted, trace is assumed.
+//
+// As in strace, a "!" before the first value negates the whole set. A "!"
+// before any other value removes it from the set built so far, so
+// "trace=%file,!close" selects all the file related syscalls except close.
+//
+// Values of the trace qualifier can be syscall names, categories ("%file",
+// "%network", etc.), regular expressions ("/^open") or the special values
+// "all" and "none". Unknown syscall names are reported as errors unless they
+// are prefixed by "?".
+func (f *Filter) Parse(expr string) error {
+	qual, value := "trace", expr
+	if i := strings.Index(expr, "="); i >= 0 {
+		qual, value = expr[:i], expr[i+1:]
+	}
+	switch qual {
+	case "trace", "t":
+		set, err := f.parseSet("trace", value, f.traceValue)
+		if err != nil {
+			return &FilterError{Expr: expr, Err: err}
+		}
+		f.trace = set
+	case "signal", "signals", "s":
+		set, err := f.parseSet("signal", value, signalValue)
+		if err != nil {
+			return &FilterError{Expr: expr, Err: err}
+		}
+		f.signals = set
+	case "status":
+		set, err := f.parseSet("status", value, statusValue)
+		if err != nil {
+			return &FilterError{Expr: expr, Err: err}
+		}
+		f.status = 0
+		for st := range set {
+			f.status |= CallStatus(st)
+		}
+	default:
+		return &FilterError{Expr: expr, Err: &ParseError{Kind: "qualifier", Value: qual}}
+	}
+	return nil
+}
+
+// parseSet parses a comma separated list of values of the qualifier qual.
+// valueFunc returns the set of members represented by each value, including
+// the special value "all".
+func (f *Filter) parseSet(qual, value string, valueFunc func(string) (map[int]bool, error)) (map[int]bool, error) {
+	negate := false
+	if strings.HasPrefix(value, "!") {
+		negate = true
+		value = value[1:]
+	}
+	if value == "" {
+		return nil, &ParseError{Kind: qual + " value", Value: value}
+	}
+
+	set := map[int]bool{}
+	for i, v := range strings.Split(value, ",") {
+		remove := false
+		if i > 0 && strings.HasPrefix(v, "!") {
+			remove = true
+			v = v[1:]
+		}
+		members, err := valueFunc(v)
+		if err != nil {
+			return nil, err
+		}
+		for m := range members {
+			if remove {
+				delete(set, m)
+			} else {
+				set[m] = true
+			}
+		}
+	}
+
+	if negate {
+		all, err := valueFunc("all")
+		if err != nil {
+			return nil, err
+		}
+		for m := range set {
+			delete(all, m)
+		}
+		set = all
+	}
+	return set, nil
+}
+
+// traceValue returns the numbers of the syscalls represented by v.
+func (f *Filter) traceValue(v string) (map[int]bool, error) {
+	set := map[int]bool{}
+	switch {
+	case v == "all":
+		for n := range f.r.tbl {
+			set[n] = true
+		}
+	case v == "none":
+	case strings.HasPrefix(v, "%"):
+		name := strings.TrimPrefix(v, "%")
+		switch name {
+		case "%stat":
+			name = "stat_like"
+		case "%statfs":
+			name = "statfs_like"
+		}
+		cat, err := ParseCategory(name)
+		if err != nil {
+			return nil, err
+		}
+		for _, sc := range f.r.SyscallsByCategory(cat) {
+			set[sc.Num] = true
+		}
+	case strings.HasPrefix(v, "/"):
+		re, err := regexp.Compile(v[1:])
+		if err != nil {
+			return nil, err
+		}
+		for n, sc := range f.r.tbl {
+			if re.MatchString(sc.Name) {
+				set[n] = true
+			}
+		}
+	default:
+		optional := strings.HasPrefix(v, "?")
+		name := strings.TrimPrefix(v, "?")
+		for n, sc := range f.r.tbl {
+			if sc.Name == name {
+				set[n] = true
+			}
+		}
+		if len(set) == 0 && !optional {
+			return nil, &UnknownSyscallError{Name: name, Arch: f.r.arch}
+		}
+	}
+	return set, nil
+}
+
+// signalValue returns the signals represented by v.
+func signalValue(v string) (map[int]bool, error) {
+	set := map[int]bool{}
+	switch v {
+	case "all":
+		for sig := 1; sig <= sigRtMax; sig++ {
+			set[sig] = true
+		}
+	case "none":
+	default:
+		sig, err := ParseSignal(v)
+		if err != nil {
+			return nil, err
+		}
+		set[sig] = true
+	}
+	return set, nil
+}
+
+// statusValue returns the statuses represented by v.
+func statusValue(v string) (map[int]bool, error) {
+	set := map[int]bool{}
+	switch v {
+	case "all":
+		for _, st := range statusNames {
+			set[int(st)] = true
+		}
+	case "none":
+	default:
+		st, ok := statusNames[v]
+		if !ok {
+			return nil, &ParseError{Kind: "status", Value: v}
+		}
+		set[int(st)] = true
+	}
+	return set, nil
+}
+
+// MatchSyscall reports whether sc is selected by the trace qualifier.
+func (f *Filter) MatchSyscall(sc Syscall) bool {
+	if f.trace == nil {
+		return true
+	}
+	return f.trace[sc.Num]
+}
+
+// MatchCall reports whether scc is selected by the trace and status
+// qualifiers.
+func (f *Filter) MatchCall(scc *SyscallCall) bool {
+	return f.MatchSyscall(scc.sc) && f.MatchStatus(scc.status())
+}
+
+// MatchStatus reports whether a call with the status st is selected by the
+// status qualifier.
+func (f *Filter) MatchStatus(st CallStatus) bool {
+	return f.status&st != 0
+}
+
+// MatchSignal reports whether the signal sig is selected by the signal
+// qualifier.
+func (f *Filter) MatchSignal(sig int) bool {
+	if f.signals == nil {
+		return true
+	}
+	return f.signals[sig]
+}
+
+// MatchRecord reports whether rec is selected by the filter. Syscall
+// records are checked against the trace and status qualifiers and signal
+// records against the signal qualifier. Process exits are always selected.
+func (f *Filter) MatchRecord(rec *TraceRecord) bool {
+	switch rec.Kind {
+	case TraceSyscall:
+		return f.MatchSyscall(rec.Syscall) && f.MatchStatus(rec.Status)
+	case TraceSignal:
+		return f.MatchSignal(rec.Signal)
+	}
+	return true
+}
+
+// status returns the status of the call.
+func (scc *SyscallCall) status() CallStatus {
+	if scc.Failed() {
+		return StatusFailed
+	}
+	return StatusSuccessful
+}
@@ -0,0 +1,161 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo_test
+
+import (
+	"testing"
+
+	"github.com/jroimartin/syscallinfo"
+	"github.com/jroimartin/syscallinfo/linux_amd64"
+)
+
+var checksFilterTrace = []struct {
+	exprs    []string
+	included []string
+	excluded []string
+	nilError bool
+}{
+	{
+		[]string{"trace=%file,!close"},
+		[]string{"open", "stat", "execve"},
+		[]string{"close", "read"},
+		true,
+	},
+	{
+		[]string{"trace=%desc,!close"},
+		[]string{"read", "open"},
+		[]string{"close", "socket"},
+		true,
+	},
+	{
+		[]string{"!open,close"},
+		[]string{"read", "write"},
+		[]string{"open", "close"},
+		true,
+	},
+	{
+		[]string{"trace=/^open"},
+		[]string{"open", "openat", "open_by_handle_at"},
+		[]string{"close"},
+		true,
+	},
+	{
+		[]string{"t=%%stat"},
+		[]string{"stat", "lstat", "fstat", "newfstatat"},
+		[]string{"statfs"},
+		true,
+	},
+	{
+		[]string{"trace=read,?socketcall"},
+		[]string{"read"},
+		[]string{"write"},
+		true,
+	},
+	{
+		[]string{"trace=read,socketcall"},
+		nil,
+		nil,
+		false,
+	},
+	{
+		[]string{"trace=%foobar"},
+		nil,
+		nil,
+		false,
+	},
+	{
+		[]string{"foobar=read"},
+		nil,
+		nil,
+		false,
+	},
+}
+
+func TestFilter_MatchSyscall(t *testing.T) {
+	r := syscallinfo.NewResolver(linux_amd64.SyscallTable)
+	for _, check := range checksFilterTrace {
+		f, err := syscallinfo.ParseFilter(r, check.exprs...)
+		if err != nil {
+			if check.nilError {
+				t.Errorf("wrong error (want=nil, get=%v)", err)
+			}
+			continue
+		}
+		if !check.nilError {
+			t.Errorf("wrong error (want=non-nil, get=nil) for %v", check.exprs)
+			continue
+		}
+		for _, name := range check.included {
+			sc, err := r.SyscallName(name)
+			if err != nil {
+				t.Fatalf("wrong error (want=nil, get=%v)", err)
+			}
+			if !f.MatchSyscall(sc) {
+				t.Errorf("%v: %v should match", check.exprs, name)
+			}
+		}
+		for _, name := range check.excluded {
+			sc, err := r.SyscallName(name)
+			if err != nil {
+				t.Fatalf("wrong error (want=nil, get=%v)", err)
+			}
+			if f.MatchSyscall(sc) {
+				t.Errorf("%v: %v should not match", check.exprs, name)
+			}
+		}
+	}
+}
+
+var checksFilterCall = []struct {
+	ret      uint64
+	wordSize int
+	match    bool
+}{
+	{3, 64, false},
+	{^uint64(0), 64, true},
+	{0xfffffffe, 64, false},
+	{0xfffffffe, 32, true},
+	{^uint64(1), 32, true},
+	{0, 32, false},
+}
+
+func TestFilter_MatchCall(t *testing.T) {
+	r := syscallinfo.NewResolver(linux_amd64.SyscallTable)
+	f, err := syscallinfo.ParseFilter(r, "trace=open", "status=failed")
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	sc, err := r.SyscallName("open")
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	for _, check := range checksFilterCall {
+		scc, err := syscallinfo.NewSyscallCall(sc, check.ret, 0, 0, 0)
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		scc.SetWordSize(check.wordSize)
+		if f.MatchCall(scc) != check.match {
+			t.Errorf("wrong match for ret=%#x/%d (want=%v, get=%v)", check.ret, check.wordSize, check.match, !check.match)
+		}
+	}
+}
+
+func TestFilter_MatchSignal(t *testing.T) {
+	r := syscallinfo.NewResolver(linux_amd64.SyscallTable)
+	f, err := syscallinfo.ParseFilter(r, "signal=!SIGCHLD,winch")
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	if f.MatchSignal(17) || f.MatchSignal(28) {
+		t.Errorf("SIGCHLD and SIGWINCH should not match")
+	}
+	if !f.MatchSignal(9) {
+		t.Errorf("SIGKILL should match")
+	}
+	if _, err := syscallinfo.ParseFilter(r, "signal=SIGFOO"); err == nil {
+		t.Errorf("wrong error (want=non-nil, get=nil)")
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A flagName links a flag value with its name.
+type flagName struct {
+	val  uint64
+	name string
+}
+
+// openFlags contains the flags of open and fcntl (F_SETFL) as defined for
+// x86. The access mode is handled separately.
+var openFlags = []flagName{
+	{0100, "O_CREAT"},
+	{0200, "O_EXCL"},
+	{0400, "O_NOCTTY"},
+	{01000, "O_TRUNC"},
+	{02000, "O_APPEND"},
+	{04000, "O_NONBLOCK"},
+	{04010000, "O_SYNC"},
+	{010000, "O_DSYNC"},
+	{020000, "O_ASYNC"},
+	{040000, "O_DIRECT"},
+	{0100000, "O_LARGEFILE"},
+	{020200000, "O_TMPFILE"},
+	{0200000, "O_DIRECTORY"},
+	{0400000, "O_NOFOLLOW"},
+	{01000000, "O_NOATIME"},
+	{02000000, "O_CLOEXEC"},
+	{010000000, "O_PATH"},
+}
+
+// formatFlags returns the names of the flags set in n separated by "|".
+// Flags are checked in order, so flags composed of several bits must be
+// placed before their components. Unknown bits are appended as a hex
+// number. If no flag is set, "0" is returned.
+func formatFlags(n uint64, flags []flagName) string {
+	var names []string
+	for _, f := range flags {
+		if n&f.val == f.val && f.val != 0 {
+			names = append(names, f.name)
+			n &^= f.val
+		}
+	}
+	if n != 0 || len(names) == 0 {
+		names = append(names, fmt.Sprintf("%#x", n))
+	}
+	return strings.Join(names, "|")
+}
+
+// formatOpenFlags returns the representation of the flags of open and
+// fcntl. The access mode is omitted when it is O_RDONLY and other flags are
+// set.
+func formatOpenFlags(n uint64) string {
+	var mode string
+	switch n & 3 {
+	case 0:
+		mode = "O_RDONLY"
+	case 1:
+		mode = "O_WRONLY"
+	case 2:
+		mode = "O_RDWR"
+	default:
+		mode = "0x3"
+	}
+	n &^= 3
+	if n == 0 {
+		return mode
+	}
+	flags := formatFlags(n, openFlags)
+	if mode == "O_RDONLY" {
+		return flags
+	}
+	return mode + "|" + flags
+}
+
+// accessModes contains the access modes of open.
+var accessModes = []flagName{
+	{0, "O_RDONLY"},
+	{1, "O_WRONLY"},
+	{2, "O_RDWR"},
+}
+
+// parseOpenFlags parses the flags of open and fcntl as formatted by
+// formatOpenFlags or strace (e.g. "O_WRONLY|O_CREAT|O_TRUNC").
+func parseOpenFlags(s string) (uint64, error) {
+	var n uint64
+	for _, name := range strings.Split(s, "|") {
+		v, ok := flagValue(name, accessModes)
+		if !ok {
+			v, ok = flagValue(name, openFlags)
+		}
+		if !ok {
+			var err error
+			if v, err = parseInt(name); err != nil {
+				return 0, &ParseError{Kind: "open flag", Value: name}
+			}
+		}
+		n |= v
+	}
+	return n, nil
+}
+
+// flagValue returns the value of the flag with the given name.
+func flagValue(name string, flags []flagName) (uint64, bool) {
+	for _, f := range flags {
+		if f.name == name {
+			return f.val, true
+		}
+	}
+	return 0, false
+}
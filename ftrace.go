@@ -0,0 +1,181 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ftraceEventRE matches the events printed by trace_pipe and trace-cmd
+	// report. The task ("comm-pid") is followed by the optional TGID
+	// (record-tgid option), the CPU, the optional latency flags and the
+	// timestamp.
+	ftraceEventRE = regexp.MustCompile(`^\s*(.+)-(\d+)\s+(?:\(\s*(?:\d+|-+)\)\s+)?\[(\d+)\]\s+(?:[^\s:]+\s+)?(\d+)(?:\.(\d+))?:\s+([\w:]+):\s*(.*)$`)
+
+	ftraceEnterRE = regexp.MustCompile(`^NR (-?\d+) \((.*)\)$`)
+	ftraceExitRE  = regexp.MustCompile(`^NR (-?\d+) = (-?\d+)$`)
+
+	// ftraceSkipRE matches the lines that do not describe events, such as
+	// the headers of trace-cmd report and the lost events notices.
+	ftraceSkipRE = regexp.MustCompile(`^(?:#|cpus=|version = |CPU ?\d+ is empty|CPU:\d+ \[LOST )`)
+)
+
+// An FtraceReader reads the raw_syscalls:sys_enter and raw_syscalls:sys_exit
+// events printed by the tracefs trace_pipe (or trace) file and by trace-cmd
+// report. Other events are ignored.
+//
+// The enter and exit events of each task are paired and returned as a
+// single record when the call returns, with the PID and timestamp of the
+// enter event. Exit events without an enter event (e.g. calls that started
+// before tracing) are returned without arguments. Calls that never return
+// (e.g. exit_group) are returned with the status StatusUnfinished at the
+// end of the log or when the task enters another syscall.
+//
+// Syscall numbers are resolved by number against the table of the reader.
+// Note that the kernel logs the numbers of the syscall table used by the
+// task, so the calls of 32-bit tasks must be resolved with the table of the
+// 32-bit arch.
+type FtraceReader struct {
+	r       Resolver
+	s       *bufio.Scanner
+	line    int
+	pending map[int]*TraceRecord
+	queue   []*TraceRecord
+}
+
+// NewFtraceReader returns an FtraceReader that reads from rd and resolves
+// syscall numbers using r.
+func NewFtraceReader(rd io.Reader, r Resolver) *FtraceReader {
+	return &FtraceReader{
+		r:       r,
+		s:       bufio.NewScanner(rd),
+		pending: map[int]*TraceRecord{},
+	}
+}
+
+// Read returns the next record of the log. It returns io.EOF when there are
+// no more records. Lines that cannot be parsed are reported as
+// TraceSyntaxError.
+func (fr *FtraceReader) Read() (*TraceRecord, error) {
+	for len(fr.queue) == 0 {
+		if !fr.s.Scan() {
+			if err := fr.s.Err(); err != nil {
+				return nil, err
+			}
+			if len(fr.pending) == 0 {
+				return nil, io.EOF
+			}
+			fr.flush(-1)
+			break
+		}
+		fr.line++
+		if err := fr.parseLine(fr.s.Text()); err != nil {
+			return nil, err
+		}
+	}
+	rec := fr.queue[0]
+	fr.queue = fr.queue[1:]
+	return rec, nil
+}
+
+// ReadAll reads all the remaining records of the log.
+func (fr *FtraceReader) ReadAll() ([]*TraceRecord, error) {
+	return ReadAllRecords(fr)
+}
+
+func (fr *FtraceReader) parseLine(line string) error {
+	text := strings.TrimSpace(line)
+	if text == "" || ftraceSkipRE.MatchString(text) {
+		return nil
+	}
+	m := ftraceEventRE.FindStringSubmatch(line)
+	if m == nil {
+		return fr.syntaxError(line)
+	}
+	event := strings.TrimPrefix(m[6], "raw_syscalls:")
+	if event != "sys_enter" && event != "sys_exit" {
+		return nil
+	}
+
+	rec := &TraceRecord{Kind: TraceSyscall, Line: fr.line, wordSize: fr.r.wordSize()}
+	rec.PID, _ = strconv.Atoi(m[2])
+	rec.Time = parseSeconds(m[4], m[5])
+	body := strings.TrimSpace(m[7])
+
+	if event == "sys_enter" {
+		em := ftraceEnterRE.FindStringSubmatch(body)
+		if em == nil {
+			return fr.syntaxError(line)
+		}
+		nr, _ := strconv.Atoi(em[1])
+		rec.Syscall = fr.r.SyscallNOrUnknown(nr)
+		for _, arg := range strings.Split(em[2], ",") {
+			arg = strings.TrimSpace(arg)
+			v, err := strconv.ParseUint(arg, 16, 64)
+			if err != nil {
+				return fr.syntaxError(line)
+			}
+			rec.Args = append(rec.Args, TraceValue{Text: arg, Value: v, Numeric: true})
+		}
+		fr.flush(rec.PID)
+		fr.pending[rec.PID] = rec
+		return nil
+	}
+
+	xm := ftraceExitRE.FindStringSubmatch(body)
+	if xm == nil {
+		return fr.syntaxError(line)
+	}
+	nr, _ := strconv.Atoi(xm[1])
+	ret, err := strconv.ParseInt(xm[2], 10, 64)
+	if err != nil {
+		return fr.syntaxError(line)
+	}
+	if enter, ok := fr.pending[rec.PID]; ok && enter.Syscall.Num == nr {
+		delete(fr.pending, rec.PID)
+		enter.Line = rec.Line
+		enter.Duration = rec.Time - enter.Time
+		rec = enter
+	} else {
+		fr.flush(rec.PID)
+		rec.Syscall = fr.r.SyscallNOrUnknown(nr)
+	}
+	rec.Ret = TraceValue{Text: xm[2], Value: uint64(ret), Numeric: true}
+	rec.Status = StatusSuccessful
+	if ret >= -4095 && ret <= -1 {
+		rec.Status = StatusFailed
+	}
+	fr.queue = append(fr.queue, rec)
+	return nil
+}
+
+// flush queues the pending call of the process pid as a call that did not
+// return. If pid is -1, the pending calls of all the processes are queued,
+// sorted by PID.
+func (fr *FtraceReader) flush(pid int) {
+	var pids []int
+	for p := range fr.pending {
+		if pid == -1 || p == pid {
+			pids = append(pids, p)
+		}
+	}
+	sort.Ints(pids)
+	for _, p := range pids {
+		rec := fr.pending[p]
+		delete(fr.pending, p)
+		rec.Status = StatusUnfinished
+		fr.queue = append(fr.queue, rec)
+	}
+}
+
+func (fr *FtraceReader) syntaxError(line string) error {
+	return &TraceSyntaxError{Format: "ftrace", Line: fr.line, Text: line}
+}
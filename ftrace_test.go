@@ -0,0 +1,99 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jroimartin/syscallinfo"
+	"github.com/jroimartin/syscallinfo/linux_amd64"
+)
+
+var checksFtraceFiles = []struct {
+	file  string
+	lines []int
+}{
+	{"trace_pipe.txt", []int{15, 18, 20, 21, 22}},
+	{"trace_cmd_report.txt", []int{7, 10, 11, 12, 13}},
+}
+
+var checksFtrace = []struct {
+	pid      int
+	time     time.Duration
+	duration time.Duration
+	name     string
+	args     []uint64
+	ret      uint64
+	status   syscallinfo.CallStatus
+}{
+	{4242, 81234000100 * time.Microsecond, 20 * time.Microsecond, "openat", []uint64{0xffffff9c, 0x7ffc5a1e2f10, 0, 0, 0, 0}, 3, syscallinfo.StatusSuccessful},
+	{4243, 81234000210 * time.Microsecond, 20 * time.Microsecond, "openat", []uint64{0xffffff9c, 0x55d0e4a1c2a0, 0, 0, 0, 0}, ^uint64(1), syscallinfo.StatusFailed},
+	{4242, 81234000200 * time.Microsecond, 50 * time.Microsecond, "read", []uint64{3, 0x7ffc5a1e1000, 0x20000, 0, 0, 0}, 1234, syscallinfo.StatusSuccessful},
+	{4242, 81234000300 * time.Microsecond, 0, "close", nil, 0, syscallinfo.StatusSuccessful},
+	{4242, 81234000400 * time.Microsecond, 0, "exit_group", []uint64{0, 0, 0, 0, 0, 0}, 0, syscallinfo.StatusUnfinished},
+}
+
+func TestFtraceReader(t *testing.T) {
+	r := syscallinfo.NewResolver(linux_amd64.SyscallTable)
+	for _, fixture := range checksFtraceFiles {
+		f, err := os.Open(filepath.Join("testdata", fixture.file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		recs, err := syscallinfo.NewFtraceReader(f, r).ReadAll()
+		f.Close()
+		if err != nil {
+			t.Fatalf("%v: wrong error (want=nil, get=%v)", fixture.file, err)
+		}
+		if len(recs) != len(checksFtrace) {
+			t.Fatalf("%v: wrong number of records (want=%v, get=%v)", fixture.file, len(checksFtrace), len(recs))
+		}
+		for i, check := range checksFtrace {
+			rec := recs[i]
+			if rec.Line != fixture.lines[i] || rec.PID != check.pid || rec.Time != check.time || rec.Duration != check.duration {
+				t.Errorf("%v: wrong record (want=%v %v %v %v, get=%v %v %v %v)", fixture.file,
+					fixture.lines[i], check.pid, check.time, check.duration,
+					rec.Line, rec.PID, rec.Time, rec.Duration)
+			}
+			if rec.Syscall.Name != check.name {
+				t.Errorf("%v: wrong syscall (want=%v, get=%v)", fixture.file, check.name, rec.Syscall.Name)
+			}
+			if len(rec.Args) != len(check.args) {
+				t.Errorf("%v: wrong number of args (want=%v, get=%v)", fixture.file, len(check.args), len(rec.Args))
+				continue
+			}
+			for j, arg := range check.args {
+				if rec.Args[j].Value != arg {
+					t.Errorf("%v: wrong arg %d (want=%#x, get=%#x)", fixture.file, j, arg, rec.Args[j].Value)
+				}
+			}
+			if rec.Ret.Value != check.ret || rec.Status != check.status {
+				t.Errorf("%v: wrong result (want=%#x %v, get=%#x %v)", fixture.file, check.ret, check.status, rec.Ret.Value, rec.Status)
+			}
+			scc, err := rec.Call()
+			if err != nil {
+				t.Fatalf("%v: wrong error (want=nil, get=%v)", fixture.file, err)
+			}
+			if scc.Failed() != (check.status == syscallinfo.StatusFailed) {
+				t.Errorf("%v: wrong failed (want=%v, get=%v)", fixture.file, !scc.Failed(), scc.Failed())
+			}
+		}
+	}
+}
+
+func TestFtraceReader_syntaxError(t *testing.T) {
+	const log = `             cat-4242    [001] ..... 81234.000100: sys_enter: NR 257 (ffffff9c, zzz)
+`
+	r := syscallinfo.NewResolver(linux_amd64.SyscallTable)
+	_, err := syscallinfo.NewFtraceReader(strings.NewReader(log), r).Read()
+	serr, ok := err.(*syscallinfo.TraceSyntaxError)
+	if !ok || serr.Format != "ftrace" || serr.Line != 1 {
+		t.Errorf("wrong error (want=ftrace: line 1, get=%v)", err)
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Directions of ioctl requests, as encoded by the _IOC macro.
+const (
+	IocNone  = 0
+	IocWrite = 1
+	IocRead  = 2
+)
+
+// IoctlCode is an ioctl request code. Its bits encode the direction of the
+// data transfer, a type (usually the driver identifier), a number and the
+// size of the argument. See include/uapi/asm-generic/ioctl.h.
+type IoctlCode uint32
+
+// Ioc returns the request code built from its fields. It is equivalent to the
+// _IOC macro.
+func Ioc(dir, typ, nr, size uint32) IoctlCode {
+	return IoctlCode(dir<<30 | size<<16 | typ<<8 | nr)
+}
+
+// Dir returns the direction of the data transfer.
+func (c IoctlCode) Dir() uint32 {
+	return uint32(c) >> 30
+}
+
+// Type returns the type of the request.
+func (c IoctlCode) Type() uint32 {
+	return uint32(c) >> 8 & 0xff
+}
+
+// Nr returns the number of the request.
+func (c IoctlCode) Nr() uint32 {
+	return uint32(c) & 0xff
+}
+
+// Size returns the size of the argument.
+func (c IoctlCode) Size() uint32 {
+	return uint32(c) >> 16 & 0x3fff
+}
+
+// String returns the name of the request if known. Otherwise, its fields are
+// returned as an _IOC expression.
+func (c IoctlCode) String() string {
+	if req, ok := ioctlRequests[c]; ok {
+		return req.Name
+	}
+	var dir string
+	switch c.Dir() {
+	case IocNone:
+		dir = "_IOC_NONE"
+	case IocWrite:
+		dir = "_IOC_WRITE"
+	case IocRead:
+		dir = "_IOC_READ"
+	default:
+		dir = "_IOC_READ|_IOC_WRITE"
+	}
+	return fmt.Sprintf("_IOC(%s, %#x, %#x, %#x)", dir, c.Type(), c.Nr(), c.Size())
+}
+
+// IoctlArgDecoder is a function that returns the representation of the
+// argument of an ioctl request. addr is the value of the argument and mem
+// gives access to the memory of the traced process.
+type IoctlArgDecoder func(mem io.ReaderAt, addr uint64) (string, error)
+
+// An IoctlRequest contains information about a known ioctl request.
+type IoctlRequest struct {
+	// Code is the request code.
+	Code IoctlCode
+
+	// Name is the name of the request (e.g. TCGETS).
+	Name string
+
+	// Decoder decodes the argument of the request. It can be nil.
+	Decoder IoctlArgDecoder
+}
+
+// ioctlRequests contains the known ioctl requests indexed by code.
+var ioctlRequests = map[IoctlCode]IoctlRequest{}
+
+// RegisterIoctl adds an ioctl request to the database of known requests. If
+// a request with the same code already exists, it is replaced.
+func RegisterIoctl(code IoctlCode, name string, dec IoctlArgDecoder) {
+	ioctlRequests[code] = IoctlRequest{Code: code, Name: name, Decoder: dec}
+}
+
+// LookupIoctl returns the ioctl request with the provided code.
+func LookupIoctl(code IoctlCode) (IoctlRequest, bool) {
+	req, ok := ioctlRequests[code]
+	return req, ok
+}
+
+// DecodeIoctlArg returns the representation of the argument arg of the ioctl
+// request code. If the request has no decoder, the raw value is returned.
+func DecodeIoctlArg(code IoctlCode, arg uint64, mem io.ReaderAt) (string, error) {
+	req, ok := ioctlRequests[code]
+	if !ok || req.Decoder == nil {
+		return fmt.Sprintf("%#08x", arg), nil
+	}
+	return req.Decoder(mem, arg)
+}
+
+// decodeInt decodes a pointer to a 32-bit integer.
+func decodeInt(mem io.ReaderAt, addr uint64) (string, error) {
+	buf, err := readMem(mem, addr, 4)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("[%d]", int32(binary.LittleEndian.Uint32(buf))), nil
+}
+
+// decodeWinsize decodes a pointer to a struct winsize.
+func decodeWinsize(mem io.ReaderAt, addr uint64) (string, error) {
+	buf, err := readMem(mem, addr, 8)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("{ws_row=%d, ws_col=%d, ws_xpixel=%d, ws_ypixel=%d}",
+		binary.LittleEndian.Uint16(buf[0:]),
+		binary.LittleEndian.Uint16(buf[2:]),
+		binary.LittleEndian.Uint16(buf[4:]),
+		binary.LittleEndian.Uint16(buf[6:])), nil
+}
+
+// readMem reads n bytes from mem at addr.
+func readMem(mem io.ReaderAt, addr uint64, n int) ([]byte, error) {
+	if mem == nil {
+		return nil, ErrMemoryRequired
+	}
+	buf := make([]byte, n)
+	if _, err := mem.ReadAt(buf, int64(addr)); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Initialize the database of known ioctl requests. Requests whose argument
+// size depends on the architecture are registered for both 32 and 64-bit
+// sizes.
+func init() {
+	for _, req := range []IoctlRequest{
+		// Terminals. See include/uapi/asm-generic/ioctls.h.
+		{0x5401, "TCGETS", nil},
+		{0x5402, "TCSETS", nil},
+		{0x5403, "TCSETSW", nil},
+		{0x5404, "TCSETSF", nil},
+		{0x5405, "TCGETA", nil},
+		{0x5406, "TCSETA", nil},
+		{0x5407, "TCSETAW", nil},
+		{0x5408, "TCSETAF", nil},
+		{0x5409, "TCSBRK", nil},
+		{0x540a, "TCXONC", nil},
+		{0x540b, "TCFLSH", nil},
+		{0x540c, "TIOCEXCL", nil},
+		{0x540d, "TIOCNXCL", nil},
+		{0x540e, "TIOCSCTTY", nil},
+		{0x540f, "TIOCGPGRP", decodeInt},
+		{0x5410, "TIOCSPGRP", decodeInt},
+		{0x5411, "TIOCOUTQ", decodeInt},
+		{0x5412, "TIOCSTI", nil},
+		{0x5413, "TIOCGWINSZ", decodeWinsize},
+		{0x5414, "TIOCSWINSZ", decodeWinsize},
+		{0x5415, "TIOCMGET", decodeInt},
+		{0x541b, "FIONREAD", decodeInt},
+		{0x5421, "FIONBIO", decodeInt},
+		{0x5422, "TIOCNOTTY", nil},
+		{0x5429, "TIOCGSID", decodeInt},
+		{0x5450, "FIONCLEX", nil},
+		{0x5451, "FIOCLEX", nil},
+		{0x5452, "FIOASYNC", decodeInt},
+		{Ioc(IocRead, 'T', 0x30, 4), "TIOCGPTN", decodeInt},
+		{Ioc(IocWrite, 'T', 0x31, 4), "TIOCSPTLCK", decodeInt},
+
+		// Block devices. See include/uapi/linux/fs.h.
+		{Ioc(IocNone, 0x12, 93, 0), "BLKROSET", decodeInt},
+		{Ioc(IocNone, 0x12, 94, 0), "BLKROGET", decodeInt},
+		{Ioc(IocNone, 0x12, 95, 0), "BLKRRPART", nil},
+		{Ioc(IocNone, 0x12, 96, 0), "BLKGETSIZE", nil},
+		{Ioc(IocNone, 0x12, 97, 0), "BLKFLSBUF", nil},
+		{Ioc(IocNone, 0x12, 98, 0), "BLKRASET", nil},
+		{Ioc(IocNone, 0x12, 99, 0), "BLKRAGET", nil},
+		{Ioc(IocNone, 0x12, 104, 0), "BLKSSZGET", decodeInt},
+		{Ioc(IocRead, 0x12, 112, 4), "BLKBSZGET", nil},
+		{Ioc(IocRead, 0x12, 112, 8), "BLKBSZGET", nil},
+		{Ioc(IocWrite, 0x12, 113, 4), "BLKBSZSET", nil},
+		{Ioc(IocWrite, 0x12, 113, 8), "BLKBSZSET", nil},
+		{Ioc(IocRead, 0x12, 114, 4), "BLKGETSIZE64", nil},
+		{Ioc(IocRead, 0x12, 114, 8), "BLKGETSIZE64", nil},
+		{Ioc(IocNone, 0x12, 119, 0), "BLKDISCARD", nil},
+		{Ioc(IocNone, 0x12, 120, 0), "BLKIOMIN", decodeInt},
+		{Ioc(IocNone, 0x12, 121, 0), "BLKIOOPT", decodeInt},
+		{Ioc(IocNone, 0x12, 123, 0), "BLKPBSZGET", decodeInt},
+
+		// File systems. See include/uapi/linux/fs.h.
+		{Ioc(IocRead, 'f', 1, 4), "FS_IOC_GETFLAGS", decodeInt},
+		{Ioc(IocRead, 'f', 1, 8), "FS_IOC_GETFLAGS", decodeInt},
+		{Ioc(IocWrite, 'f', 2, 4), "FS_IOC_SETFLAGS", decodeInt},
+		{Ioc(IocWrite, 'f', 2, 8), "FS_IOC_SETFLAGS", decodeInt},
+		{Ioc(IocRead|IocWrite, 'X', 119, 4), "FIFREEZE", nil},
+		{Ioc(IocRead|IocWrite, 'X', 120, 4), "FITHAW", nil},
+		{Ioc(IocWrite, 0x94, 9, 4), "FICLONE", nil},
+
+		// Loop devices. See include/uapi/linux/loop.h.
+		{0x4c00, "LOOP_SET_FD", nil},
+		{0x4c01, "LOOP_CLR_FD", nil},
+		{0x4c04, "LOOP_SET_STATUS64", nil},
+		{0x4c05, "LOOP_GET_STATUS64", nil},
+		{0x4c82, "LOOP_CTL_GET_FREE", nil},
+
+		// Network interfaces. See include/uapi/linux/sockios.h.
+		{0x8912, "SIOCGIFCONF", nil},
+		{0x8913, "SIOCGIFFLAGS", nil},
+		{0x8914, "SIOCSIFFLAGS", nil},
+		{0x8915, "SIOCGIFADDR", nil},
+		{0x8921, "SIOCGIFMTU", nil},
+		{0x8927, "SIOCGIFHWADDR", nil},
+		{0x8933, "SIOCGIFINDEX", nil},
+
+		// DRM. See include/uapi/drm/drm.h.
+		{Ioc(IocRead|IocWrite, 'd', 0x00, 36), "DRM_IOCTL_VERSION", nil},
+		{Ioc(IocRead|IocWrite, 'd', 0x00, 64), "DRM_IOCTL_VERSION", nil},
+		{Ioc(IocRead|IocWrite, 'd', 0x01, 8), "DRM_IOCTL_GET_UNIQUE", nil},
+		{Ioc(IocRead|IocWrite, 'd', 0x01, 16), "DRM_IOCTL_GET_UNIQUE", nil},
+		{Ioc(IocRead, 'd', 0x02, 4), "DRM_IOCTL_GET_MAGIC", nil},
+		{Ioc(IocWrite, 'd', 0x09, 8), "DRM_IOCTL_GEM_CLOSE", nil},
+		{Ioc(IocRead|IocWrite, 'd', 0x0c, 16), "DRM_IOCTL_GET_CAP", nil},
+		{Ioc(IocWrite, 'd', 0x0d, 16), "DRM_IOCTL_SET_CLIENT_CAP", nil},
+		{Ioc(IocWrite, 'd', 0x11, 4), "DRM_IOCTL_AUTH_MAGIC", nil},
+		{Ioc(IocNone, 'd', 0x1e, 0), "DRM_IOCTL_SET_MASTER", nil},
+		{Ioc(IocNone, 'd', 0x1f, 0), "DRM_IOCTL_DROP_MASTER", nil},
+		{Ioc(IocRead|IocWrite, 'd', 0x2d, 12), "DRM_IOCTL_PRIME_HANDLE_TO_FD", nil},
+		{Ioc(IocRead|IocWrite, 'd', 0x2e, 12), "DRM_IOCTL_PRIME_FD_TO_HANDLE", nil},
+		{Ioc(IocRead|IocWrite, 'd', 0xa0, 64), "DRM_IOCTL_MODE_GETRESOURCES", nil},
+
+		// KVM. See include/uapi/linux/kvm.h.
+		{Ioc(IocNone, 0xae, 0x00, 0), "KVM_GET_API_VERSION", nil},
+		{Ioc(IocNone, 0xae, 0x01, 0), "KVM_CREATE_VM", nil},
+		{Ioc(IocNone, 0xae, 0x03, 0), "KVM_CHECK_EXTENSION", nil},
+		{Ioc(IocNone, 0xae, 0x04, 0), "KVM_GET_VCPU_MMAP_SIZE", nil},
+		{Ioc(IocNone, 0xae, 0x41, 0), "KVM_CREATE_VCPU", nil},
+		{Ioc(IocWrite, 0xae, 0x46, 32), "KVM_SET_USER_MEMORY_REGION", nil},
+		{Ioc(IocNone, 0xae, 0x60, 0), "KVM_CREATE_IRQCHIP", nil},
+		{Ioc(IocWrite, 0xae, 0x76, 32), "KVM_IRQFD", nil},
+		{Ioc(IocWrite, 0xae, 0x79, 64), "KVM_IOEVENTFD", nil},
+		{Ioc(IocNone, 0xae, 0x80, 0), "KVM_RUN", nil},
+		{Ioc(IocRead, 0xae, 0x81, 144), "KVM_GET_REGS", nil},
+		{Ioc(IocWrite, 0xae, 0x82, 144), "KVM_SET_REGS", nil},
+		{Ioc(IocRead, 0xae, 0x83, 312), "KVM_GET_SREGS", nil},
+		{Ioc(IocWrite, 0xae, 0x84, 312), "KVM_SET_SREGS", nil},
+	} {
+		RegisterIoctl(req.Code, req.Name, req.Decoder)
+	}
+}
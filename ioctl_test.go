@@ -0,0 +1,77 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo_test
+
+import (
+	"testing"
+
+	"github.com/jroimartin/syscallinfo"
+	"github.com/jroimartin/syscallinfo/linux_amd64"
+)
+
+var checksIoctlCode = []struct {
+	code syscallinfo.IoctlCode
+	dir  uint32
+	typ  uint32
+	nr   uint32
+	size uint32
+	str  string
+}{
+	{0x5401, syscallinfo.IocNone, 'T', 0x01, 0, "TCGETS"},
+	{0x80081272, syscallinfo.IocRead, 0x12, 114, 8, "BLKGETSIZE64"},
+	{0x8138ae83, syscallinfo.IocRead, 0xae, 0x83, 312, "KVM_GET_SREGS"},
+	{0xc0406400, syscallinfo.IocRead | syscallinfo.IocWrite, 'd', 0, 64, "DRM_IOCTL_VERSION"},
+	{0x40087a01, syscallinfo.IocWrite, 'z', 1, 8, "_IOC(_IOC_WRITE, 0x7a, 0x1, 0x8)"},
+}
+
+func TestIoctlCode(t *testing.T) {
+	for _, check := range checksIoctlCode {
+		c := check.code
+		if c.Dir() != check.dir || c.Type() != check.typ || c.Nr() != check.nr || c.Size() != check.size {
+			t.Errorf("wrong fields for %#x (want=%v/%v/%v/%v, get=%v/%v/%v/%v)", uint32(c),
+				check.dir, check.typ, check.nr, check.size,
+				c.Dir(), c.Type(), c.Nr(), c.Size())
+		}
+		if syscallinfo.Ioc(check.dir, check.typ, check.nr, check.size) != c {
+			t.Errorf("wrong code (want=%#x, get=%#x)", uint32(c),
+				uint32(syscallinfo.Ioc(check.dir, check.typ, check.nr, check.size)))
+		}
+		if c.String() != check.str {
+			t.Errorf("wrong string (want=%v, get=%v)", check.str, c.String())
+		}
+	}
+}
+
+func TestDecodeIoctlArg(t *testing.T) {
+	mem := newMem(0x100, 0x00500018, 0)
+	str, err := syscallinfo.DecodeIoctlArg(0x5413, 0x100, mem)
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	want := "{ws_row=24, ws_col=80, ws_xpixel=0, ws_ypixel=0}"
+	if str != want {
+		t.Errorf("wrong string (want=%v, get=%v)", want, str)
+	}
+}
+
+func TestSyscallCall_OutputIoctl(t *testing.T) {
+	r := syscallinfo.NewResolver(linux_amd64.SyscallTable)
+	sc, err := r.SyscallName("ioctl")
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	scc, err := syscallinfo.NewSyscallCall(sc, 0, 1, 0x5413, 0x100)
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	want := "ioctl(0x00000001, TIOCGWINSZ, 0x00000100)"
+	str, err := scc.Output(0)
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	if str != want {
+		t.Errorf("wrong string (want=%v, get=%v)", want, str)
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// jsonSyscall is the representation of a syscall in the JSON syscall
+// tables (e.g. syscall_64.json). The optional fields are set by the
+// annotations of mksyscalltable.go.
+type jsonSyscall struct {
+	Entry      string         `json:"entry"`
+	Num        int            `json:"num"`
+	Args       []jsonArgument `json:"args"`
+	Name       string         `json:"name"`
+	Context    Context        `json:"context"`
+	Status     SyscallStatus  `json:"status,omitempty"`
+	Categories []string       `json:"categories,omitempty"`
+	Since      string         `json:"since,omitempty"`
+	Until      string         `json:"until,omitempty"`
+}
+
+// jsonArgument is the representation of a syscall argument in the JSON
+// syscall tables.
+type jsonArgument struct {
+	RefCount int     `json:"refcount"`
+	Sig      string  `json:"sig"`
+	Context  Context `json:"context"`
+}
+
+// MarshalJSON implements JSON marshaling for syscalls, using the format of
+// the entries of the JSON syscall tables.
+func (sc Syscall) MarshalJSON() ([]byte, error) {
+	jsc := jsonSyscall{
+		Entry:      sc.Entry,
+		Num:        sc.Num,
+		Args:       make([]jsonArgument, len(sc.Args)),
+		Name:       sc.Name,
+		Context:    sc.Context,
+		Status:     sc.Status,
+		Categories: sc.Categories.Names(),
+	}
+	for i, arg := range sc.Args {
+		jsc.Args[i] = jsonArgument{RefCount: arg.RefCount, Sig: arg.Sig, Context: arg.Context}
+	}
+	if !sc.Since.IsZero() {
+		jsc.Since = sc.Since.String()
+	}
+	if !sc.Until.IsZero() {
+		jsc.Until = sc.Until.String()
+	}
+	return json.Marshal(jsc)
+}
+
+// UnmarshalJSON implements JSON unmarshaling for syscalls.
+func (sc *Syscall) UnmarshalJSON(data []byte) error {
+	var jsc jsonSyscall
+	if err := json.Unmarshal(data, &jsc); err != nil {
+		return err
+	}
+	s := Syscall{
+		Num:     jsc.Num,
+		Name:    jsc.Name,
+		Entry:   jsc.Entry,
+		Context: jsc.Context,
+		Args:    make([]Argument, len(jsc.Args)),
+		Status:  jsc.Status,
+	}
+	for i, arg := range jsc.Args {
+		s.Args[i] = Argument{RefCount: arg.RefCount, Sig: arg.Sig, Context: arg.Context}
+	}
+	for _, name := range jsc.Categories {
+		cat, err := ParseCategory(name)
+		if err != nil {
+			return err
+		}
+		s.Categories |= cat
+	}
+	var err error
+	if jsc.Since != "" {
+		if s.Since, err = ParseKernelVersion(jsc.Since); err != nil {
+			return err
+		}
+	}
+	if jsc.Until != "" {
+		if s.Until, err = ParseKernelVersion(jsc.Until); err != nil {
+			return err
+		}
+	}
+	*sc = s
+	return nil
+}
+
+// MarshalJSON implements JSON marshaling for syscall tables. The table is
+// encoded as a list of syscalls sorted by number, using the same format as
+// the files consumed by mksyscalltable.go.
+func (tbl SyscallTable) MarshalJSON() ([]byte, error) {
+	scs := make([]Syscall, 0, len(tbl))
+	for _, n := range tableNums(tbl) {
+		scs = append(scs, tbl[n])
+	}
+	return json.Marshal(scs)
+}
+
+// UnmarshalJSON implements JSON unmarshaling for syscall tables. If several
+// syscalls share the same number, it returns a ValidationError with the
+// problem ProbDuplicateNum.
+func (tbl *SyscallTable) UnmarshalJSON(data []byte) error {
+	var scs []Syscall
+	if err := json.Unmarshal(data, &scs); err != nil {
+		return err
+	}
+	t := make(SyscallTable, len(scs))
+	for _, sc := range scs {
+		if _, dup := t[sc.Num]; dup {
+			return &ValidationError{Num: sc.Num, Name: sc.Name, Arg: -1, Problem: ProbDuplicateNum}
+		}
+		t[sc.Num] = sc
+	}
+	*tbl = t
+	return nil
+}
+
+// LoadTable reads a syscall table in the JSON format consumed by
+// mksyscalltable.go (e.g. syscall_64.json). The categories and kernel
+// versions are optional. The table is not checked for inconsistencies, use
+// Validate for that.
+func LoadTable(r io.Reader) (SyscallTable, error) {
+	var tbl SyscallTable
+	if err := json.NewDecoder(r).Decode(&tbl); err != nil {
+		return nil, err
+	}
+	return tbl, nil
+}
@@ -0,0 +1,203 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jroimartin/syscallinfo"
+	"github.com/jroimartin/syscallinfo/linux_386"
+	"github.com/jroimartin/syscallinfo/linux_amd64"
+)
+
+func TestSyscallTable_MarshalJSON(t *testing.T) {
+	for _, check := range checksBinary {
+		data, err := json.Marshal(check.tbl)
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		tbl, err := syscallinfo.LoadTable(strings.NewReader(string(data)))
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		if !reflect.DeepEqual(tbl, check.tbl) {
+			t.Errorf("%v: loaded table differs from the original one", check.name)
+		}
+	}
+}
+
+func TestSyscall_MarshalJSON(t *testing.T) {
+	for _, sc := range linux_amd64.SyscallTable {
+		data, err := json.Marshal(sc)
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		var get syscallinfo.Syscall
+		if err := json.Unmarshal(data, &get); err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		if !reflect.DeepEqual(get, sc) {
+			t.Errorf("wrong syscall %d (want=%+v, get=%+v)", sc.Num, sc, get)
+		}
+	}
+}
+
+var checksLoadTable = []struct {
+	filename string
+	tbl      syscallinfo.SyscallTable
+}{
+	{"linux_386/syscall_32.json", linux_386.SyscallTable},
+	{"linux_amd64/syscall_64.json", linux_amd64.SyscallTable},
+	{"linux_amd64/syscall_64_4.0.json", linux_amd64.SyscallTable4_0},
+}
+
+func TestLoadTable(t *testing.T) {
+	for _, check := range checksLoadTable {
+		f, err := os.Open(check.filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tbl, err := syscallinfo.LoadTable(f)
+		f.Close()
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		if len(tbl) != len(check.tbl) {
+			t.Errorf("%v: wrong number of syscalls (want=%v, get=%v)", check.filename, len(check.tbl), len(tbl))
+		}
+		// Categories and versions come from the annotation files.
+		for n, want := range check.tbl {
+			want.Categories = 0
+			want.Since = syscallinfo.KernelVersion{}
+			want.Until = syscallinfo.KernelVersion{}
+			if get := tbl[n]; !reflect.DeepEqual(get, want) {
+				t.Errorf("%v: wrong syscall %d (want=%+v, get=%+v)", check.filename, n, want, get)
+			}
+		}
+	}
+}
+
+var checksLoadTableErrors = []struct {
+	data string
+	want string
+}{
+	{`{}`, "cannot unmarshal"},
+	{`[{"num": 0, "name": "read"}, {"num": 0, "name": "write"}]`, "syscall 0 (write): duplicate number"},
+	{`[{"num": 0, "name": "read", "status": "BROKEN"}]`, `invalid status "BROKEN"`},
+	{`[{"num": 0, "name": "read", "categories": ["files"]}]`, `invalid category "files"`},
+	{`[{"num": 0, "name": "read", "since": "2.x"}]`, `invalid kernel version "2.x"`},
+	{`[{"num": 0, "name": "read", "context": "FILE_DESC"}]`, `invalid context "FILE_DESC"`},
+	{`[{"num": 0, "name": "read", "args": [{"sig": "int fd", "context": 1}]}]`, `invalid context "1"`},
+}
+
+func TestLoadTable_errors(t *testing.T) {
+	for _, check := range checksLoadTableErrors {
+		_, err := syscallinfo.LoadTable(strings.NewReader(check.data))
+		if err == nil || !strings.Contains(err.Error(), check.want) {
+			t.Errorf("wrong error (want=%v, get=%v)", check.want, err)
+		}
+	}
+}
+
+var checksContextJSON = []struct {
+	ctx   syscallinfo.Context
+	want  string
+	gostr string
+}{
+	{syscallinfo.CtxNone, `""`, "syscallinfo.CtxNone"},
+	{syscallinfo.CtxFD, `"FD"`, "syscallinfo.CtxFD"},
+	{syscallinfo.CtxIoctlReq, `"IOCTL_REQ"`, "syscallinfo.CtxIoctlReq"},
+	{syscallinfo.CtxOpenFlags, `"OPEN_FLAGS"`, "syscallinfo.CtxOpenFlags"},
+	{syscallinfo.CtxSignal, `"SIGNAL"`, "syscallinfo.CtxSignal"},
+}
+
+func TestContext_MarshalJSON(t *testing.T) {
+	for _, check := range checksContextJSON {
+		data, err := json.Marshal(check.ctx)
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		if string(data) != check.want {
+			t.Errorf("wrong JSON (want=%v, get=%s)", check.want, data)
+		}
+		var ctx syscallinfo.Context
+		if err := json.Unmarshal(data, &ctx); err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		if ctx != check.ctx {
+			t.Errorf("wrong context (want=%v, get=%v)", check.ctx, ctx)
+		}
+		if get := fmt.Sprintf("%#v", check.ctx); get != check.gostr {
+			t.Errorf("wrong Go syntax (want=%v, get=%v)", check.gostr, get)
+		}
+	}
+}
+
+func TestContext_unknown(t *testing.T) {
+	ctx := syscallinfo.Context(42)
+	if get := ctx.String(); get != "Context(42)" {
+		t.Errorf("wrong string (want=Context(42), get=%v)", get)
+	}
+	if _, err := json.Marshal(ctx); err == nil {
+		t.Error("wrong error (want=error, get=nil)")
+	}
+	if err := ctx.UnmarshalText([]byte("fd")); err == nil {
+		t.Error("wrong error (want=error, get=nil)")
+	}
+	if ctx != 42 {
+		t.Errorf("context modified on error (want=42, get=%d)", ctx)
+	}
+}
+
+var checksStatusJSON = []struct {
+	st    syscallinfo.SyscallStatus
+	want  string
+	gostr string
+}{
+	{syscallinfo.SyscallOK, `""`, "syscallinfo.SyscallOK"},
+	{syscallinfo.SyscallUnknownSignature, `"UNKNOWN_SIGNATURE"`, "syscallinfo.SyscallUnknownSignature"},
+	{syscallinfo.SyscallNotImplemented, `"NOT_IMPLEMENTED"`, "syscallinfo.SyscallNotImplemented"},
+	{syscallinfo.SyscallUnknownNumber, `"UNKNOWN_NUMBER"`, "syscallinfo.SyscallUnknownNumber"},
+}
+
+func TestSyscallStatus_MarshalJSON(t *testing.T) {
+	for _, check := range checksStatusJSON {
+		data, err := json.Marshal(check.st)
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		if string(data) != check.want {
+			t.Errorf("wrong JSON (want=%v, get=%s)", check.want, data)
+		}
+		var st syscallinfo.SyscallStatus
+		if err := json.Unmarshal(data, &st); err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		if st != check.st {
+			t.Errorf("wrong status (want=%#v, get=%#v)", check.st, st)
+		}
+		if get := fmt.Sprintf("%#v", check.st); get != check.gostr {
+			t.Errorf("wrong Go syntax (want=%v, get=%v)", check.gostr, get)
+		}
+	}
+}
+
+func TestSyscallStatus_unknown(t *testing.T) {
+	st := syscallinfo.SyscallStatus(42)
+	if get := st.String(); get != "SyscallStatus(42)" {
+		t.Errorf("wrong string (want=SyscallStatus(42), get=%v)", get)
+	}
+	if _, err := json.Marshal(st); err == nil {
+		t.Error("wrong error (want=error, get=nil)")
+	}
+	if err := st.UnmarshalText([]byte("OK")); err == nil {
+		t.Error("wrong error (want=error, get=nil)")
+	}
+}
@@ -4,4 +4,7 @@
 
 package linux_386
 
-//go:generate go run $GOPATH/src/github.com/jroimartin/syscallinfo/mksyscalltable.go -output syscalltable.go linux_386 syscall_32.json
+//go:generate go run $GOPATH/src/github.com/jroimartin/syscallinfo/mksyscalltable.go -output syscalltable.go -categories ../categories.json -versions ../versions.json linux_386 syscall_32.json
+//go:generate go run $GOPATH/src/github.com/jroimartin/syscallinfo/mksyscalltable.go -output syscalltable_4_0.go -var SyscallTable4_0 -categories ../categories.json -versions ../versions.json linux_386 syscall_32_4.0.json
+//go:generate go run $GOPATH/src/github.com/jroimartin/syscallinfo/mksyscalltable.go -embed -output syscalltable_embed.go -categories ../categories.json -versions ../versions.json linux_386 syscall_32.json
+//go:generate go run $GOPATH/src/github.com/jroimartin/syscallinfo/mksyscalltable.go -embed -output syscalltable_4_0_embed.go -var SyscallTable4_0 -categories ../categories.json -versions ../versions.json linux_386 syscall_32_4.0.json
@@ -0,0 +1,13 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linux_386
+
+import "github.com/jroimartin/syscallinfo"
+
+func init() {
+	syscallinfo.Register("linux_386", SyscallTable)
+	syscallinfo.RegisterSnapshot("linux_386", "4.0", SyscallTable4_0)
+	syscallinfo.RegisterSnapshot("linux_386", "6.15", SyscallTable)
+}
@@ -1,6919 +1,10386 @@
 // MACHINE GENERATED BY 'go generate' COMMAND; DO NOT EDIT
 
+//go:build !syscallinfo_embed
+// +build !syscallinfo_embed
+
 package linux_386
 
 import "github.com/jroimartin/syscallinfo"
 
 var SyscallTable = syscallinfo.SyscallTable{
 	0: syscallinfo.Syscall{
-		Num:     0,
-		Name:    "restart_syscall",
-		Entry:   "sys_restart_syscall",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        0,
+		Name:       "restart_syscall",
+		Entry:      "sys_restart_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
 	},
 	1: syscallinfo.Syscall{
 		Num:     1,
 		Name:    "exit",
 		Entry:   "sys_exit",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int error_code",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatProcess,
 	},
 	2: syscallinfo.Syscall{
-		Num:     2,
-		Name:    "fork",
-		Entry:   "sys_fork",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        2,
+		Name:       "fork",
+		Entry:      "sys_fork",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatProcess,
 	},
 	3: syscallinfo.Syscall{
 		Num:     3,
 		Name:    "read",
 		Entry:   "sys_read",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  1,
+				Context:  syscallinfo.CtxFD,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	4: syscallinfo.Syscall{
 		Num:     4,
 		Name:    "write",
 		Entry:   "sys_write",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	5: syscallinfo.Syscall{
 		Num:     5,
 		Name:    "open",
 		Entry:   "sys_open",
-		Context: 1,
+		Context: syscallinfo.CtxFD,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "umode_t mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
 	},
 	6: syscallinfo.Syscall{
 		Num:     6,
 		Name:    "close",
 		Entry:   "sys_close",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	7: syscallinfo.Syscall{
 		Num:     7,
 		Name:    "waitpid",
 		Entry:   "sys_waitpid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "int __user *stat_addr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int options",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatProcess,
 	},
 	8: syscallinfo.Syscall{
 		Num:     8,
 		Name:    "creat",
 		Entry:   "sys_creat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *pathname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "umode_t mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
 	},
 	9: syscallinfo.Syscall{
 		Num:     9,
 		Name:    "link",
 		Entry:   "sys_link",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *oldname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *newname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	10: syscallinfo.Syscall{
 		Num:     10,
 		Name:    "unlink",
 		Entry:   "sys_unlink",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *pathname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	11: syscallinfo.Syscall{
 		Num:     11,
 		Name:    "execve",
 		Entry:   "sys_execve",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 2,
 				Sig:      "const char __user *const __user *argv",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 2,
 				Sig:      "const char __user *const __user *envp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatProcess,
 	},
 	12: syscallinfo.Syscall{
 		Num:     12,
 		Name:    "chdir",
 		Entry:   "sys_chdir",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	13: syscallinfo.Syscall{
 		Num:     13,
 		Name:    "time",
 		Entry:   "sys_time",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "time_t __user *tloc",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatClock,
 	},
 	14: syscallinfo.Syscall{
 		Num:     14,
 		Name:    "mknod",
 		Entry:   "sys_mknod",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "umode_t mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned dev",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	15: syscallinfo.Syscall{
 		Num:     15,
 		Name:    "chmod",
 		Entry:   "sys_chmod",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "umode_t mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	16: syscallinfo.Syscall{
 		Num:     16,
 		Name:    "lchown",
 		Entry:   "sys_lchown16",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "old_uid_t user",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "old_gid_t group",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
+	},
+	17: syscallinfo.Syscall{
+		Num:        17,
+		Name:       "break",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	18: syscallinfo.Syscall{
 		Num:     18,
 		Name:    "oldstat",
 		Entry:   "sys_stat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct __old_kernel_stat __user *statbuf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatStat | syscallinfo.CatStatLike,
 	},
 	19: syscallinfo.Syscall{
 		Num:     19,
 		Name:    "lseek",
 		Entry:   "sys_lseek",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "off_t offset",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int whence",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	20: syscallinfo.Syscall{
-		Num:     20,
-		Name:    "getpid",
-		Entry:   "sys_getpid",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        20,
+		Name:       "getpid",
+		Entry:      "sys_getpid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatPure,
 	},
 	21: syscallinfo.Syscall{
 		Num:     21,
 		Name:    "mount",
 		Entry:   "sys_mount",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "char __user *dev_name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *dir_name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *type",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "void __user *data",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	22: syscallinfo.Syscall{
 		Num:     22,
 		Name:    "umount",
 		Entry:   "sys_oldumount",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	23: syscallinfo.Syscall{
 		Num:     23,
 		Name:    "setuid",
 		Entry:   "sys_setuid16",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "old_uid_t uid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	24: syscallinfo.Syscall{
-		Num:     24,
-		Name:    "getuid",
-		Entry:   "sys_getuid16",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        24,
+		Name:       "getuid",
+		Entry:      "sys_getuid16",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatCreds | syscallinfo.CatPure,
 	},
 	25: syscallinfo.Syscall{
 		Num:     25,
 		Name:    "stime",
 		Entry:   "sys_stime",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "time_t __user *tptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatClock,
 	},
 	26: syscallinfo.Syscall{
 		Num:     26,
 		Name:    "ptrace",
 		Entry:   "sys_ptrace",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "long request",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "long pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long addr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long data",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	27: syscallinfo.Syscall{
 		Num:     27,
 		Name:    "alarm",
 		Entry:   "sys_alarm",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int seconds",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	28: syscallinfo.Syscall{
 		Num:     28,
 		Name:    "oldfstat",
 		Entry:   "sys_fstat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct __old_kernel_stat __user *statbuf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFstat | syscallinfo.CatStatLike,
 	},
 	29: syscallinfo.Syscall{
-		Num:     29,
-		Name:    "pause",
-		Entry:   "sys_pause",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        29,
+		Name:       "pause",
+		Entry:      "sys_pause",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatSignal,
 	},
 	30: syscallinfo.Syscall{
 		Num:     30,
 		Name:    "utime",
 		Entry:   "sys_utime",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct utimbuf __user *times",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
+	},
+	31: syscallinfo.Syscall{
+		Num:        31,
+		Name:       "stty",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	32: syscallinfo.Syscall{
+		Num:        32,
+		Name:       "gtty",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	33: syscallinfo.Syscall{
 		Num:     33,
 		Name:    "access",
 		Entry:   "sys_access",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	34: syscallinfo.Syscall{
 		Num:     34,
 		Name:    "nice",
 		Entry:   "sys_nice",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int increment",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+	},
+	35: syscallinfo.Syscall{
+		Num:        35,
+		Name:       "ftime",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	36: syscallinfo.Syscall{
-		Num:     36,
-		Name:    "sync",
-		Entry:   "sys_sync",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        36,
+		Name:       "sync",
+		Entry:      "sys_sync",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
 	},
 	37: syscallinfo.Syscall{
 		Num:     37,
 		Name:    "kill",
 		Entry:   "sys_kill",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int sig",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	38: syscallinfo.Syscall{
 		Num:     38,
 		Name:    "rename",
 		Entry:   "sys_rename",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *oldname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *newname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	39: syscallinfo.Syscall{
 		Num:     39,
 		Name:    "mkdir",
 		Entry:   "sys_mkdir",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *pathname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "umode_t mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	40: syscallinfo.Syscall{
 		Num:     40,
 		Name:    "rmdir",
 		Entry:   "sys_rmdir",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *pathname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	41: syscallinfo.Syscall{
 		Num:     41,
 		Name:    "dup",
 		Entry:   "sys_dup",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fildes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	42: syscallinfo.Syscall{
 		Num:     42,
 		Name:    "pipe",
 		Entry:   "sys_pipe",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "int __user *fildes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	43: syscallinfo.Syscall{
 		Num:     43,
 		Name:    "times",
 		Entry:   "sys_times",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct tms __user *tbuf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+	},
+	44: syscallinfo.Syscall{
+		Num:        44,
+		Name:       "prof",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	45: syscallinfo.Syscall{
 		Num:     45,
 		Name:    "brk",
 		Entry:   "sys_brk",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long brk",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	46: syscallinfo.Syscall{
 		Num:     46,
 		Name:    "setgid",
 		Entry:   "sys_setgid16",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "old_gid_t gid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	47: syscallinfo.Syscall{
-		Num:     47,
-		Name:    "getgid",
-		Entry:   "sys_getgid16",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        47,
+		Name:       "getgid",
+		Entry:      "sys_getgid16",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatCreds | syscallinfo.CatPure,
 	},
 	48: syscallinfo.Syscall{
 		Num:     48,
 		Name:    "signal",
 		Entry:   "sys_signal",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int sig",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "__sighandler_t handler",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	49: syscallinfo.Syscall{
-		Num:     49,
-		Name:    "geteuid",
-		Entry:   "sys_geteuid16",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        49,
+		Name:       "geteuid",
+		Entry:      "sys_geteuid16",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatCreds | syscallinfo.CatPure,
 	},
 	50: syscallinfo.Syscall{
-		Num:     50,
-		Name:    "getegid",
-		Entry:   "sys_getegid16",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        50,
+		Name:       "getegid",
+		Entry:      "sys_getegid16",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatCreds | syscallinfo.CatPure,
 	},
 	51: syscallinfo.Syscall{
 		Num:     51,
 		Name:    "acct",
 		Entry:   "sys_acct",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	52: syscallinfo.Syscall{
 		Num:     52,
 		Name:    "umount2",
 		Entry:   "sys_umount",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
+	},
+	53: syscallinfo.Syscall{
+		Num:        53,
+		Name:       "lock",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	54: syscallinfo.Syscall{
 		Num:     54,
 		Name:    "ioctl",
 		Entry:   "sys_ioctl",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int cmd",
-				Context:  0,
+				Context:  syscallinfo.CtxIoctlReq,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	55: syscallinfo.Syscall{
 		Num:     55,
 		Name:    "fcntl",
 		Entry:   "sys_fcntl",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxFD,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int cmd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+	},
+	56: syscallinfo.Syscall{
+		Num:        56,
+		Name:       "mpx",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	57: syscallinfo.Syscall{
 		Num:     57,
 		Name:    "setpgid",
 		Entry:   "sys_setpgid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "pid_t pgid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+	},
+	58: syscallinfo.Syscall{
+		Num:        58,
+		Name:       "ulimit",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	59: syscallinfo.Syscall{
 		Num:     59,
 		Name:    "oldolduname",
 		Entry:   "sys_olduname",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct oldold_utsname __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	60: syscallinfo.Syscall{
 		Num:     60,
 		Name:    "umask",
 		Entry:   "sys_umask",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int mask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	61: syscallinfo.Syscall{
 		Num:     61,
 		Name:    "chroot",
 		Entry:   "sys_chroot",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	62: syscallinfo.Syscall{
 		Num:     62,
 		Name:    "ustat",
 		Entry:   "sys_ustat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned dev",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct ustat __user *ubuf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatStatfsLike,
 	},
 	63: syscallinfo.Syscall{
 		Num:     63,
 		Name:    "dup2",
 		Entry:   "sys_dup2",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int oldfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int newfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	64: syscallinfo.Syscall{
-		Num:     64,
-		Name:    "getppid",
-		Entry:   "sys_getppid",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        64,
+		Name:       "getppid",
+		Entry:      "sys_getppid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatPure,
 	},
 	65: syscallinfo.Syscall{
-		Num:     65,
-		Name:    "getpgrp",
-		Entry:   "sys_getpgrp",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        65,
+		Name:       "getpgrp",
+		Entry:      "sys_getpgrp",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatPure,
 	},
 	66: syscallinfo.Syscall{
-		Num:     66,
-		Name:    "setsid",
-		Entry:   "sys_setsid",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        66,
+		Name:       "setsid",
+		Entry:      "sys_setsid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
 	},
 	67: syscallinfo.Syscall{
 		Num:     67,
 		Name:    "sigaction",
 		Entry:   "sys_sigaction",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct old_sigaction __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct old_sigaction __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	68: syscallinfo.Syscall{
-		Num:     68,
-		Name:    "sgetmask",
-		Entry:   "sys_sgetmask",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        68,
+		Name:       "sgetmask",
+		Entry:      "sys_sgetmask",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatSignal,
 	},
 	69: syscallinfo.Syscall{
 		Num:     69,
 		Name:    "ssetmask",
 		Entry:   "sys_ssetmask",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int newmask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	70: syscallinfo.Syscall{
 		Num:     70,
 		Name:    "setreuid",
 		Entry:   "sys_setreuid16",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "old_uid_t ruid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "old_uid_t euid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	71: syscallinfo.Syscall{
 		Num:     71,
 		Name:    "setregid",
 		Entry:   "sys_setregid16",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "old_gid_t rgid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "old_gid_t egid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	72: syscallinfo.Syscall{
 		Num:     72,
 		Name:    "sigsuspend",
 		Entry:   "sys_sigsuspend",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int unused1",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int unused2",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "old_sigset_t mask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	73: syscallinfo.Syscall{
 		Num:     73,
 		Name:    "sigpending",
 		Entry:   "sys_sigpending",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "old_sigset_t __user *set",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	74: syscallinfo.Syscall{
 		Num:     74,
 		Name:    "sethostname",
 		Entry:   "sys_sethostname",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	75: syscallinfo.Syscall{
 		Num:     75,
 		Name:    "setrlimit",
 		Entry:   "sys_setrlimit",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int resource",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct rlimit __user *rlim",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	76: syscallinfo.Syscall{
 		Num:     76,
 		Name:    "getrlimit",
 		Entry:   "sys_old_getrlimit",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int resource",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct rlimit __user *rlim",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	77: syscallinfo.Syscall{
 		Num:     77,
 		Name:    "getrusage",
 		Entry:   "sys_getrusage",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int who",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct rusage __user *ru",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	78: syscallinfo.Syscall{
 		Num:     78,
 		Name:    "gettimeofday",
 		Entry:   "sys_gettimeofday",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct timeval __user *tv",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timezone __user *tz",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatClock,
 	},
 	79: syscallinfo.Syscall{
 		Num:     79,
 		Name:    "settimeofday",
 		Entry:   "sys_settimeofday",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct timeval __user *tv",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timezone __user *tz",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatClock,
 	},
 	80: syscallinfo.Syscall{
 		Num:     80,
 		Name:    "getgroups",
 		Entry:   "sys_getgroups16",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int gidsetsize",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "old_gid_t __user *grouplist",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	81: syscallinfo.Syscall{
 		Num:     81,
 		Name:    "setgroups",
 		Entry:   "sys_setgroups16",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int gidsetsize",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "old_gid_t __user *grouplist",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	82: syscallinfo.Syscall{
 		Num:     82,
 		Name:    "select",
 		Entry:   "sys_old_select",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct sel_arg_struct __user *arg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	83: syscallinfo.Syscall{
 		Num:     83,
 		Name:    "symlink",
 		Entry:   "sys_symlink",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *old",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *new",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	84: syscallinfo.Syscall{
 		Num:     84,
 		Name:    "oldlstat",
 		Entry:   "sys_lstat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct __old_kernel_stat __user *statbuf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatLstat | syscallinfo.CatStatLike,
 	},
 	85: syscallinfo.Syscall{
 		Num:     85,
 		Name:    "readlink",
 		Entry:   "sys_readlink",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int bufsiz",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	86: syscallinfo.Syscall{
 		Num:     86,
 		Name:    "uselib",
 		Entry:   "sys_uselib",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *library",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	87: syscallinfo.Syscall{
 		Num:     87,
 		Name:    "swapon",
 		Entry:   "sys_swapon",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *specialfile",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int swap_flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	88: syscallinfo.Syscall{
 		Num:     88,
 		Name:    "reboot",
 		Entry:   "sys_reboot",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int magic1",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int magic2",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int cmd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "void __user *arg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	89: syscallinfo.Syscall{
 		Num:     89,
 		Name:    "readdir",
 		Entry:   "sys_old_readdir",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct old_linux_dirent __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	90: syscallinfo.Syscall{
 		Num:     90,
 		Name:    "mmap",
 		Entry:   "sys_old_mmap",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct mmap_arg_struct __user *arg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatMemory,
 	},
 	91: syscallinfo.Syscall{
 		Num:     91,
 		Name:    "munmap",
 		Entry:   "sys_munmap",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long addr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	92: syscallinfo.Syscall{
 		Num:     92,
 		Name:    "truncate",
 		Entry:   "sys_truncate",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "long length",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	93: syscallinfo.Syscall{
 		Num:     93,
 		Name:    "ftruncate",
 		Entry:   "sys_ftruncate",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long length",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	94: syscallinfo.Syscall{
 		Num:     94,
 		Name:    "fchmod",
 		Entry:   "sys_fchmod",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "umode_t mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	95: syscallinfo.Syscall{
 		Num:     95,
 		Name:    "fchown",
 		Entry:   "sys_fchown16",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "old_uid_t user",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "old_gid_t group",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	96: syscallinfo.Syscall{
 		Num:     96,
 		Name:    "getpriority",
 		Entry:   "sys_getpriority",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int which",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int who",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	97: syscallinfo.Syscall{
 		Num:     97,
 		Name:    "setpriority",
 		Entry:   "sys_setpriority",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int which",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int who",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int niceval",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+	},
+	98: syscallinfo.Syscall{
+		Num:        98,
+		Name:       "profil",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	99: syscallinfo.Syscall{
 		Num:     99,
 		Name:    "statfs",
 		Entry:   "sys_statfs",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user * path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct statfs __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatStatfs | syscallinfo.CatStatfsLike,
 	},
 	100: syscallinfo.Syscall{
 		Num:     100,
 		Name:    "fstatfs",
 		Entry:   "sys_fstatfs",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct statfs __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatFstatfs | syscallinfo.CatStatfsLike,
 	},
 	101: syscallinfo.Syscall{
 		Num:     101,
 		Name:    "ioperm",
 		Entry:   "sys_ioperm",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	102: syscallinfo.Syscall{
 		Num:     102,
 		Name:    "socketcall",
 		Entry:   "sys_socketcall",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int call",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "unsigned long __user *args",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
 	},
 	103: syscallinfo.Syscall{
 		Num:     103,
 		Name:    "syslog",
 		Entry:   "sys_syslog",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int type",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	104: syscallinfo.Syscall{
 		Num:     104,
 		Name:    "setitimer",
 		Entry:   "sys_setitimer",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int which",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct itimerval __user *value",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct itimerval __user *ovalue",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	105: syscallinfo.Syscall{
 		Num:     105,
 		Name:    "getitimer",
 		Entry:   "sys_getitimer",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int which",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct itimerval __user *value",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	106: syscallinfo.Syscall{
 		Num:     106,
 		Name:    "stat",
 		Entry:   "sys_newstat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct stat __user *statbuf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatStat | syscallinfo.CatStatLike,
 	},
 	107: syscallinfo.Syscall{
 		Num:     107,
 		Name:    "lstat",
 		Entry:   "sys_newlstat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct stat __user *statbuf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatLstat | syscallinfo.CatStatLike,
 	},
 	108: syscallinfo.Syscall{
 		Num:     108,
 		Name:    "fstat",
 		Entry:   "sys_newfstat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct stat __user *statbuf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatFstat | syscallinfo.CatStatLike,
 	},
 	109: syscallinfo.Syscall{
 		Num:     109,
 		Name:    "olduname",
 		Entry:   "sys_uname",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct old_utsname __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	110: syscallinfo.Syscall{
 		Num:     110,
 		Name:    "iopl",
 		Entry:   "sys_iopl",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	111: syscallinfo.Syscall{
-		Num:     111,
-		Name:    "vhangup",
-		Entry:   "sys_vhangup",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        111,
+		Name:       "vhangup",
+		Entry:      "sys_vhangup",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+	},
+	112: syscallinfo.Syscall{
+		Num:        112,
+		Name:       "idle",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	113: syscallinfo.Syscall{
 		Num:     113,
 		Name:    "vm86old",
 		Entry:   "sys_vm86old",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct vm86_struct __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	114: syscallinfo.Syscall{
 		Num:     114,
 		Name:    "wait4",
 		Entry:   "sys_wait4",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "int __user *stat_addr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int options",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct rusage __user *ru",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatProcess,
 	},
 	115: syscallinfo.Syscall{
 		Num:     115,
 		Name:    "swapoff",
 		Entry:   "sys_swapoff",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *specialfile",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	116: syscallinfo.Syscall{
 		Num:     116,
 		Name:    "sysinfo",
 		Entry:   "sys_sysinfo",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct sysinfo __user *info",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	117: syscallinfo.Syscall{
 		Num:     117,
 		Name:    "ipc",
 		Entry:   "sys_ipc",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int call",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int first",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long second",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long third",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "void __user *ptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "long fifth",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatIPC,
 	},
 	118: syscallinfo.Syscall{
 		Num:     118,
 		Name:    "fsync",
 		Entry:   "sys_fsync",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	119: syscallinfo.Syscall{
-		Num:     119,
-		Name:    "sigreturn",
-		Entry:   "sys_sigreturn",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        119,
+		Name:       "sigreturn",
+		Entry:      "sys_sigreturn",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatSignal,
 	},
 	120: syscallinfo.Syscall{
 		Num:     120,
 		Name:    "clone",
 		Entry:   "sys_clone",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "int __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "int __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatProcess,
 	},
 	121: syscallinfo.Syscall{
 		Num:     121,
 		Name:    "setdomainname",
 		Entry:   "sys_setdomainname",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	122: syscallinfo.Syscall{
 		Num:     122,
 		Name:    "uname",
 		Entry:   "sys_newuname",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct new_utsname __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	123: syscallinfo.Syscall{
 		Num:     123,
 		Name:    "modify_ldt",
 		Entry:   "sys_modify_ldt",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "void __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	124: syscallinfo.Syscall{
 		Num:     124,
 		Name:    "adjtimex",
 		Entry:   "sys_adjtimex",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct timex __user *txc_p",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatClock,
 	},
 	125: syscallinfo.Syscall{
 		Num:     125,
 		Name:    "mprotect",
 		Entry:   "sys_mprotect",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long start",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long prot",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	126: syscallinfo.Syscall{
 		Num:     126,
 		Name:    "sigprocmask",
 		Entry:   "sys_sigprocmask",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int how",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "old_sigset_t __user *set",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "old_sigset_t __user *oset",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
+	},
+	127: syscallinfo.Syscall{
+		Num:        127,
+		Name:       "create_module",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Until:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 0},
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	128: syscallinfo.Syscall{
 		Num:     128,
 		Name:    "init_module",
 		Entry:   "sys_init_module",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "void __user *umod",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *uargs",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	129: syscallinfo.Syscall{
 		Num:     129,
 		Name:    "delete_module",
 		Entry:   "sys_delete_module",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name_user",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+	},
+	130: syscallinfo.Syscall{
+		Num:        130,
+		Name:       "get_kernel_syms",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Until:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 0},
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	131: syscallinfo.Syscall{
 		Num:     131,
 		Name:    "quotactl",
 		Entry:   "sys_quotactl",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int cmd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *special",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "qid_t id",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "void __user *addr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	132: syscallinfo.Syscall{
 		Num:     132,
 		Name:    "getpgid",
 		Entry:   "sys_getpgid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	133: syscallinfo.Syscall{
 		Num:     133,
 		Name:    "fchdir",
 		Entry:   "sys_fchdir",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	134: syscallinfo.Syscall{
 		Num:     134,
 		Name:    "bdflush",
 		Entry:   "sys_bdflush",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int func",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "long data",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	135: syscallinfo.Syscall{
 		Num:     135,
 		Name:    "sysfs",
 		Entry:   "sys_sysfs",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int option",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg1",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg2",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	136: syscallinfo.Syscall{
 		Num:     136,
 		Name:    "personality",
 		Entry:   "sys_personality",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int personality",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+	},
+	137: syscallinfo.Syscall{
+		Num:        137,
+		Name:       "afs_syscall",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	138: syscallinfo.Syscall{
 		Num:     138,
 		Name:    "setfsuid",
 		Entry:   "sys_setfsuid16",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "old_uid_t uid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	139: syscallinfo.Syscall{
 		Num:     139,
 		Name:    "setfsgid",
 		Entry:   "sys_setfsgid16",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "old_gid_t gid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	140: syscallinfo.Syscall{
 		Num:     140,
 		Name:    "_llseek",
 		Entry:   "sys_llseek",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long offset_high",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long offset_low",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "loff_t __user *result",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int whence",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	141: syscallinfo.Syscall{
 		Num:     141,
 		Name:    "getdents",
 		Entry:   "sys_getdents",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct linux_dirent __user *dirent",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	142: syscallinfo.Syscall{
 		Num:     142,
 		Name:    "_newselect",
 		Entry:   "sys_select",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int n",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "fd_set __user *inp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "fd_set __user *outp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "fd_set __user *exp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timeval __user *tvp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	143: syscallinfo.Syscall{
 		Num:     143,
 		Name:    "flock",
 		Entry:   "sys_flock",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int cmd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	144: syscallinfo.Syscall{
 		Num:     144,
 		Name:    "msync",
 		Entry:   "sys_msync",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long start",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	145: syscallinfo.Syscall{
 		Num:     145,
 		Name:    "readv",
 		Entry:   "sys_readv",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct iovec __user *vec",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long vlen",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	146: syscallinfo.Syscall{
 		Num:     146,
 		Name:    "writev",
 		Entry:   "sys_writev",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct iovec __user *vec",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long vlen",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	147: syscallinfo.Syscall{
 		Num:     147,
 		Name:    "getsid",
 		Entry:   "sys_getsid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	148: syscallinfo.Syscall{
 		Num:     148,
 		Name:    "fdatasync",
 		Entry:   "sys_fdatasync",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	149: syscallinfo.Syscall{
-		Num:     149,
-		Name:    "_sysctl",
-		Entry:   "sys_sysctl",
-		Context: 0,
-		Args: []syscallinfo.Argument{
-			{
-				RefCount: 1,
-				Sig:      "struct __sysctl_args __user *args",
-				Context:  0,
-			},
-		},
+		Num:        149,
+		Name:       "_sysctl",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Until:      syscallinfo.KernelVersion{Major: 5, Minor: 5, Patch: 0},
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	150: syscallinfo.Syscall{
 		Num:     150,
 		Name:    "mlock",
 		Entry:   "sys_mlock",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long start",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	151: syscallinfo.Syscall{
 		Num:     151,
 		Name:    "munlock",
 		Entry:   "sys_munlock",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long start",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	152: syscallinfo.Syscall{
 		Num:     152,
 		Name:    "mlockall",
 		Entry:   "sys_mlockall",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	153: syscallinfo.Syscall{
-		Num:     153,
-		Name:    "munlockall",
-		Entry:   "sys_munlockall",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        153,
+		Name:       "munlockall",
+		Entry:      "sys_munlockall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatMemory,
 	},
 	154: syscallinfo.Syscall{
 		Num:     154,
 		Name:    "sched_setparam",
 		Entry:   "sys_sched_setparam",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sched_param __user *param",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	155: syscallinfo.Syscall{
 		Num:     155,
 		Name:    "sched_getparam",
 		Entry:   "sys_sched_getparam",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sched_param __user *param",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	156: syscallinfo.Syscall{
 		Num:     156,
 		Name:    "sched_setscheduler",
 		Entry:   "sys_sched_setscheduler",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int policy",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sched_param __user *param",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	157: syscallinfo.Syscall{
 		Num:     157,
 		Name:    "sched_getscheduler",
 		Entry:   "sys_sched_getscheduler",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	158: syscallinfo.Syscall{
-		Num:     158,
-		Name:    "sched_yield",
-		Entry:   "sys_sched_yield",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        158,
+		Name:       "sched_yield",
+		Entry:      "sys_sched_yield",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
 	},
 	159: syscallinfo.Syscall{
 		Num:     159,
 		Name:    "sched_get_priority_max",
 		Entry:   "sys_sched_get_priority_max",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int policy",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	160: syscallinfo.Syscall{
 		Num:     160,
 		Name:    "sched_get_priority_min",
 		Entry:   "sys_sched_get_priority_min",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int policy",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	161: syscallinfo.Syscall{
 		Num:     161,
 		Name:    "sched_rr_get_interval",
 		Entry:   "sys_sched_rr_get_interval",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *interval",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	162: syscallinfo.Syscall{
 		Num:     162,
 		Name:    "nanosleep",
 		Entry:   "sys_nanosleep",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *rqtp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *rmtp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	163: syscallinfo.Syscall{
 		Num:     163,
 		Name:    "mremap",
 		Entry:   "sys_mremap",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long addr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long old_len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long new_len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long new_addr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	164: syscallinfo.Syscall{
 		Num:     164,
 		Name:    "setresuid",
 		Entry:   "sys_setresuid16",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "old_uid_t ruid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "old_uid_t euid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "old_uid_t suid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	165: syscallinfo.Syscall{
 		Num:     165,
 		Name:    "getresuid",
 		Entry:   "sys_getresuid16",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "old_uid_t __user *ruid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "old_uid_t __user *euid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "old_uid_t __user *suid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	166: syscallinfo.Syscall{
 		Num:     166,
 		Name:    "vm86",
 		Entry:   "sys_vm86",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+	},
+	167: syscallinfo.Syscall{
+		Num:        167,
+		Name:       "query_module",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Until:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 0},
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	168: syscallinfo.Syscall{
 		Num:     168,
 		Name:    "poll",
 		Entry:   "sys_poll",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct pollfd __user *ufds",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int nfds",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int timeout",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+	},
+	169: syscallinfo.Syscall{
+		Num:        169,
+		Name:       "nfsservctl",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Until:      syscallinfo.KernelVersion{Major: 3, Minor: 1, Patch: 0},
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	170: syscallinfo.Syscall{
 		Num:     170,
 		Name:    "setresgid",
 		Entry:   "sys_setresgid16",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "old_gid_t rgid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "old_gid_t egid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "old_gid_t sgid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	171: syscallinfo.Syscall{
 		Num:     171,
 		Name:    "getresgid",
 		Entry:   "sys_getresgid16",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "old_gid_t __user *rgid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "old_gid_t __user *egid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "old_gid_t __user *sgid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	172: syscallinfo.Syscall{
 		Num:     172,
 		Name:    "prctl",
 		Entry:   "sys_prctl",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int option",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg2",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg3",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg4",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg5",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	173: syscallinfo.Syscall{
-		Num:     173,
-		Name:    "rt_sigreturn",
-		Entry:   "sys_rt_sigreturn",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        173,
+		Name:       "rt_sigreturn",
+		Entry:      "sys_rt_sigreturn",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatSignal,
 	},
 	174: syscallinfo.Syscall{
 		Num:     174,
 		Name:    "rt_sigaction",
 		Entry:   "sys_rt_sigaction",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct sigaction __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sigaction __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	175: syscallinfo.Syscall{
 		Num:     175,
 		Name:    "rt_sigprocmask",
 		Entry:   "sys_rt_sigprocmask",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int how",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "sigset_t __user *set",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "sigset_t __user *oset",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t sigsetsize",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	176: syscallinfo.Syscall{
 		Num:     176,
 		Name:    "rt_sigpending",
 		Entry:   "sys_rt_sigpending",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "sigset_t __user *set",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t sigsetsize",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	177: syscallinfo.Syscall{
 		Num:     177,
 		Name:    "rt_sigtimedwait",
 		Entry:   "sys_rt_sigtimedwait",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const sigset_t __user *uthese",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "siginfo_t __user *uinfo",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct timespec __user *uts",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t sigsetsize",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	178: syscallinfo.Syscall{
 		Num:     178,
 		Name:    "rt_sigqueueinfo",
 		Entry:   "sys_rt_sigqueueinfo",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int sig",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "siginfo_t __user *uinfo",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	179: syscallinfo.Syscall{
 		Num:     179,
 		Name:    "rt_sigsuspend",
 		Entry:   "sys_rt_sigsuspend",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "sigset_t __user *unewset",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t sigsetsize",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	180: syscallinfo.Syscall{
 		Num:     180,
 		Name:    "pread64",
 		Entry:   "sys_pread64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "loff_t pos",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	181: syscallinfo.Syscall{
 		Num:     181,
 		Name:    "pwrite64",
 		Entry:   "sys_pwrite64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "loff_t pos",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	182: syscallinfo.Syscall{
 		Num:     182,
 		Name:    "chown",
 		Entry:   "sys_chown16",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "old_uid_t user",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "old_gid_t group",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	183: syscallinfo.Syscall{
 		Num:     183,
 		Name:    "getcwd",
 		Entry:   "sys_getcwd",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "char __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	184: syscallinfo.Syscall{
 		Num:     184,
 		Name:    "capget",
 		Entry:   "sys_capget",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "cap_user_header_t header",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "cap_user_data_t dataptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	185: syscallinfo.Syscall{
 		Num:     185,
 		Name:    "capset",
 		Entry:   "sys_capset",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "cap_user_header_t header",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "const cap_user_data_t data",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	186: syscallinfo.Syscall{
 		Num:     186,
 		Name:    "sigaltstack",
 		Entry:   "sys_sigaltstack",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const struct sigaltstack __user *uss",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sigaltstack __user *uoss",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	187: syscallinfo.Syscall{
 		Num:     187,
 		Name:    "sendfile",
 		Entry:   "sys_sendfile",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int out_fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int in_fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "off_t __user *offset",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+	},
+	188: syscallinfo.Syscall{
+		Num:        188,
+		Name:       "getpmsg",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	189: syscallinfo.Syscall{
+		Num:        189,
+		Name:       "putpmsg",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	190: syscallinfo.Syscall{
-		Num:     190,
-		Name:    "vfork",
-		Entry:   "sys_vfork",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        190,
+		Name:       "vfork",
+		Entry:      "sys_vfork",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatProcess,
 	},
 	191: syscallinfo.Syscall{
 		Num:     191,
 		Name:    "ugetrlimit",
 		Entry:   "sys_getrlimit",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int resource",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct rlimit __user *rlim",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	192: syscallinfo.Syscall{
 		Num:     192,
 		Name:    "mmap2",
 		Entry:   "sys_mmap_pgoff",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long addr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long prot",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long pgoff",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatMemory,
 	},
 	193: syscallinfo.Syscall{
 		Num:     193,
 		Name:    "truncate64",
 		Entry:   "sys_truncate64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "loff_t length",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	194: syscallinfo.Syscall{
 		Num:     194,
 		Name:    "ftruncate64",
 		Entry:   "sys_ftruncate64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "loff_t length",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	195: syscallinfo.Syscall{
 		Num:     195,
 		Name:    "stat64",
 		Entry:   "sys_stat64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct stat64 __user *statbuf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatStat | syscallinfo.CatStatLike,
 	},
 	196: syscallinfo.Syscall{
 		Num:     196,
 		Name:    "lstat64",
 		Entry:   "sys_lstat64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct stat64 __user *statbuf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatLstat | syscallinfo.CatStatLike,
 	},
 	197: syscallinfo.Syscall{
 		Num:     197,
 		Name:    "fstat64",
 		Entry:   "sys_fstat64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct stat64 __user *statbuf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatFstat | syscallinfo.CatStatLike,
 	},
 	198: syscallinfo.Syscall{
 		Num:     198,
 		Name:    "lchown32",
 		Entry:   "sys_lchown",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "uid_t user",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "gid_t group",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	199: syscallinfo.Syscall{
-		Num:     199,
-		Name:    "getuid32",
-		Entry:   "sys_getuid",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        199,
+		Name:       "getuid32",
+		Entry:      "sys_getuid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatCreds | syscallinfo.CatPure,
 	},
 	200: syscallinfo.Syscall{
-		Num:     200,
-		Name:    "getgid32",
-		Entry:   "sys_getgid",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        200,
+		Name:       "getgid32",
+		Entry:      "sys_getgid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatCreds | syscallinfo.CatPure,
 	},
 	201: syscallinfo.Syscall{
-		Num:     201,
-		Name:    "geteuid32",
-		Entry:   "sys_geteuid",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        201,
+		Name:       "geteuid32",
+		Entry:      "sys_geteuid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatCreds | syscallinfo.CatPure,
 	},
 	202: syscallinfo.Syscall{
-		Num:     202,
-		Name:    "getegid32",
-		Entry:   "sys_getegid",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        202,
+		Name:       "getegid32",
+		Entry:      "sys_getegid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatCreds | syscallinfo.CatPure,
 	},
 	203: syscallinfo.Syscall{
 		Num:     203,
 		Name:    "setreuid32",
 		Entry:   "sys_setreuid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "uid_t ruid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "uid_t euid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	204: syscallinfo.Syscall{
 		Num:     204,
 		Name:    "setregid32",
 		Entry:   "sys_setregid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "gid_t rgid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "gid_t egid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	205: syscallinfo.Syscall{
 		Num:     205,
 		Name:    "getgroups32",
 		Entry:   "sys_getgroups",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int gidsetsize",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "gid_t __user *grouplist",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	206: syscallinfo.Syscall{
 		Num:     206,
 		Name:    "setgroups32",
 		Entry:   "sys_setgroups",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int gidsetsize",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "gid_t __user *grouplist",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	207: syscallinfo.Syscall{
 		Num:     207,
 		Name:    "fchown32",
 		Entry:   "sys_fchown",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "uid_t user",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "gid_t group",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	208: syscallinfo.Syscall{
 		Num:     208,
 		Name:    "setresuid32",
 		Entry:   "sys_setresuid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "uid_t ruid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "uid_t euid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "uid_t suid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	209: syscallinfo.Syscall{
 		Num:     209,
 		Name:    "getresuid32",
 		Entry:   "sys_getresuid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "uid_t __user *ruid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "uid_t __user *euid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "uid_t __user *suid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	210: syscallinfo.Syscall{
 		Num:     210,
 		Name:    "setresgid32",
 		Entry:   "sys_setresgid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "gid_t rgid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "gid_t egid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "gid_t sgid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	211: syscallinfo.Syscall{
 		Num:     211,
 		Name:    "getresgid32",
 		Entry:   "sys_getresgid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "gid_t __user *rgid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "gid_t __user *egid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "gid_t __user *sgid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	212: syscallinfo.Syscall{
 		Num:     212,
 		Name:    "chown32",
 		Entry:   "sys_chown",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "uid_t user",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "gid_t group",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	213: syscallinfo.Syscall{
 		Num:     213,
 		Name:    "setuid32",
 		Entry:   "sys_setuid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "uid_t uid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	214: syscallinfo.Syscall{
 		Num:     214,
 		Name:    "setgid32",
 		Entry:   "sys_setgid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "gid_t gid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	215: syscallinfo.Syscall{
 		Num:     215,
 		Name:    "setfsuid32",
 		Entry:   "sys_setfsuid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "uid_t uid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	216: syscallinfo.Syscall{
 		Num:     216,
 		Name:    "setfsgid32",
 		Entry:   "sys_setfsgid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "gid_t gid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	217: syscallinfo.Syscall{
 		Num:     217,
 		Name:    "pivot_root",
 		Entry:   "sys_pivot_root",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *new_root",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *put_old",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	218: syscallinfo.Syscall{
 		Num:     218,
 		Name:    "mincore",
 		Entry:   "sys_mincore",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long start",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "unsigned char __user * vec",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	219: syscallinfo.Syscall{
 		Num:     219,
 		Name:    "madvise",
 		Entry:   "sys_madvise",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long start",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int behavior",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	220: syscallinfo.Syscall{
 		Num:     220,
 		Name:    "getdents64",
 		Entry:   "sys_getdents64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct linux_dirent64 __user *dirent",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	221: syscallinfo.Syscall{
 		Num:     221,
 		Name:    "fcntl64",
 		Entry:   "sys_fcntl64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxFD,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int cmd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	224: syscallinfo.Syscall{
-		Num:     224,
-		Name:    "gettid",
-		Entry:   "sys_gettid",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        224,
+		Name:       "gettid",
+		Entry:      "sys_gettid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatPure,
 	},
 	225: syscallinfo.Syscall{
 		Num:     225,
 		Name:    "readahead",
 		Entry:   "sys_readahead",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "loff_t offset",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	226: syscallinfo.Syscall{
 		Num:     226,
 		Name:    "setxattr",
 		Entry:   "sys_setxattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const void __user *value",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	227: syscallinfo.Syscall{
 		Num:     227,
 		Name:    "lsetxattr",
 		Entry:   "sys_lsetxattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const void __user *value",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	228: syscallinfo.Syscall{
 		Num:     228,
 		Name:    "fsetxattr",
 		Entry:   "sys_fsetxattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const void __user *value",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	229: syscallinfo.Syscall{
 		Num:     229,
 		Name:    "getxattr",
 		Entry:   "sys_getxattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "void __user *value",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	230: syscallinfo.Syscall{
 		Num:     230,
 		Name:    "lgetxattr",
 		Entry:   "sys_lgetxattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "void __user *value",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	231: syscallinfo.Syscall{
 		Num:     231,
 		Name:    "fgetxattr",
 		Entry:   "sys_fgetxattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "void __user *value",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	232: syscallinfo.Syscall{
 		Num:     232,
 		Name:    "listxattr",
 		Entry:   "sys_listxattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *list",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	233: syscallinfo.Syscall{
 		Num:     233,
 		Name:    "llistxattr",
 		Entry:   "sys_llistxattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *list",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	234: syscallinfo.Syscall{
 		Num:     234,
 		Name:    "flistxattr",
 		Entry:   "sys_flistxattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *list",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	235: syscallinfo.Syscall{
 		Num:     235,
 		Name:    "removexattr",
 		Entry:   "sys_removexattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	236: syscallinfo.Syscall{
 		Num:     236,
 		Name:    "lremovexattr",
 		Entry:   "sys_lremovexattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	237: syscallinfo.Syscall{
 		Num:     237,
 		Name:    "fremovexattr",
 		Entry:   "sys_fremovexattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	238: syscallinfo.Syscall{
 		Num:     238,
 		Name:    "tkill",
 		Entry:   "sys_tkill",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int sig",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	239: syscallinfo.Syscall{
 		Num:     239,
 		Name:    "sendfile64",
 		Entry:   "sys_sendfile64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int out_fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int in_fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "loff_t __user *offset",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	240: syscallinfo.Syscall{
 		Num:     240,
 		Name:    "futex",
 		Entry:   "sys_futex",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "u32 __user *uaddr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int op",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "u32 val",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *utime",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "u32 __user *uaddr2",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "u32 val3",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	241: syscallinfo.Syscall{
 		Num:     241,
 		Name:    "sched_setaffinity",
 		Entry:   "sys_sched_setaffinity",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "unsigned long __user *user_mask_ptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	242: syscallinfo.Syscall{
 		Num:     242,
 		Name:    "sched_getaffinity",
 		Entry:   "sys_sched_getaffinity",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "unsigned long __user *user_mask_ptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	243: syscallinfo.Syscall{
 		Num:     243,
 		Name:    "set_thread_area",
 		Entry:   "sys_set_thread_area",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct user_desc __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	244: syscallinfo.Syscall{
 		Num:     244,
 		Name:    "get_thread_area",
 		Entry:   "sys_get_thread_area",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct user_desc __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	245: syscallinfo.Syscall{
 		Num:     245,
 		Name:    "io_setup",
 		Entry:   "sys_io_setup",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned nr_reqs",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "aio_context_t __user *ctx",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	246: syscallinfo.Syscall{
 		Num:     246,
 		Name:    "io_destroy",
 		Entry:   "sys_io_destroy",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "aio_context_t ctx",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	247: syscallinfo.Syscall{
 		Num:     247,
 		Name:    "io_getevents",
 		Entry:   "sys_io_getevents",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "aio_context_t ctx_id",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "long min_nr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "long nr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct io_event __user *events",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *timeout",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	248: syscallinfo.Syscall{
 		Num:     248,
 		Name:    "io_submit",
 		Entry:   "sys_io_submit",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "aio_context_t",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "long",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 2,
 				Sig:      "struct iocb __user * __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	249: syscallinfo.Syscall{
 		Num:     249,
 		Name:    "io_cancel",
 		Entry:   "sys_io_cancel",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "aio_context_t ctx_id",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct iocb __user *iocb",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct io_event __user *result",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	250: syscallinfo.Syscall{
 		Num:     250,
 		Name:    "fadvise64",
 		Entry:   "sys_fadvise64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "loff_t offset",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int advice",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	252: syscallinfo.Syscall{
 		Num:     252,
 		Name:    "exit_group",
 		Entry:   "sys_exit_group",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int error_code",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatProcess,
 	},
 	253: syscallinfo.Syscall{
-		Num:     253,
-		Name:    "lookup_dcookie",
-		Entry:   "sys_lookup_dcookie",
-		Context: 0,
-		Args: []syscallinfo.Argument{
-			{
-				RefCount: 0,
-				Sig:      "u64 cookie64",
-				Context:  0,
-			},
-			{
-				RefCount: 1,
-				Sig:      "char __user *buf",
-				Context:  0,
-			},
-			{
-				RefCount: 0,
-				Sig:      "size_t len",
-				Context:  0,
-			},
-		},
+		Num:        253,
+		Name:       "lookup_dcookie",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Until:      syscallinfo.KernelVersion{Major: 6, Minor: 8, Patch: 0},
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	254: syscallinfo.Syscall{
 		Num:     254,
 		Name:    "epoll_create",
 		Entry:   "sys_epoll_create",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	255: syscallinfo.Syscall{
 		Num:     255,
 		Name:    "epoll_ctl",
 		Entry:   "sys_epoll_ctl",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int epfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int op",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct epoll_event __user *event",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	256: syscallinfo.Syscall{
 		Num:     256,
 		Name:    "epoll_wait",
 		Entry:   "sys_epoll_wait",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int epfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct epoll_event __user *events",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int maxevents",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int timeout",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	257: syscallinfo.Syscall{
 		Num:     257,
 		Name:    "remap_file_pages",
 		Entry:   "sys_remap_file_pages",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long start",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long prot",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long pgoff",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	258: syscallinfo.Syscall{
 		Num:     258,
 		Name:    "set_tid_address",
 		Entry:   "sys_set_tid_address",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "int __user *tidptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	259: syscallinfo.Syscall{
 		Num:     259,
 		Name:    "timer_create",
 		Entry:   "sys_timer_create",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "clockid_t which_clock",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sigevent __user *timer_event_spec",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "timer_t __user * created_timer_id",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	260: syscallinfo.Syscall{
 		Num:     260,
 		Name:    "timer_settime",
 		Entry:   "sys_timer_settime",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "timer_t timer_id",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct itimerspec __user *new_setting",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct itimerspec __user *old_setting",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	261: syscallinfo.Syscall{
 		Num:     261,
 		Name:    "timer_gettime",
 		Entry:   "sys_timer_gettime",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "timer_t timer_id",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct itimerspec __user *setting",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	262: syscallinfo.Syscall{
 		Num:     262,
 		Name:    "timer_getoverrun",
 		Entry:   "sys_timer_getoverrun",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "timer_t timer_id",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	263: syscallinfo.Syscall{
 		Num:     263,
 		Name:    "timer_delete",
 		Entry:   "sys_timer_delete",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "timer_t timer_id",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	264: syscallinfo.Syscall{
 		Num:     264,
 		Name:    "clock_settime",
 		Entry:   "sys_clock_settime",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "clockid_t which_clock",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct timespec __user *tp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatClock,
 	},
 	265: syscallinfo.Syscall{
 		Num:     265,
 		Name:    "clock_gettime",
 		Entry:   "sys_clock_gettime",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "clockid_t which_clock",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *tp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatClock,
 	},
 	266: syscallinfo.Syscall{
 		Num:     266,
 		Name:    "clock_getres",
 		Entry:   "sys_clock_getres",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "clockid_t which_clock",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *tp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatClock,
 	},
 	267: syscallinfo.Syscall{
 		Num:     267,
 		Name:    "clock_nanosleep",
 		Entry:   "sys_clock_nanosleep",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "clockid_t which_clock",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct timespec __user *rqtp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *rmtp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	268: syscallinfo.Syscall{
 		Num:     268,
 		Name:    "statfs64",
 		Entry:   "sys_statfs64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t sz",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct statfs64 __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatStatfs | syscallinfo.CatStatfsLike,
 	},
 	269: syscallinfo.Syscall{
 		Num:     269,
 		Name:    "fstatfs64",
 		Entry:   "sys_fstatfs64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t sz",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct statfs64 __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatFstatfs | syscallinfo.CatStatfsLike,
 	},
 	270: syscallinfo.Syscall{
 		Num:     270,
 		Name:    "tgkill",
 		Entry:   "sys_tgkill",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int tgid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int sig",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	271: syscallinfo.Syscall{
 		Num:     271,
 		Name:    "utimes",
 		Entry:   "sys_utimes",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timeval __user *utimes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	272: syscallinfo.Syscall{
 		Num:     272,
 		Name:    "fadvise64_64",
 		Entry:   "sys_fadvise64_64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "loff_t offset",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "loff_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int advice",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+	},
+	273: syscallinfo.Syscall{
+		Num:        273,
+		Name:       "vserver",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	274: syscallinfo.Syscall{
 		Num:     274,
 		Name:    "mbind",
 		Entry:   "sys_mbind",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long start",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const unsigned long __user *nmask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long maxnode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
 	},
 	275: syscallinfo.Syscall{
 		Num:     275,
 		Name:    "get_mempolicy",
 		Entry:   "sys_get_mempolicy",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "int __user *policy",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "unsigned long __user *nmask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long maxnode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long addr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
 	},
 	276: syscallinfo.Syscall{
 		Num:     276,
 		Name:    "set_mempolicy",
 		Entry:   "sys_set_mempolicy",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const unsigned long __user *nmask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long maxnode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
 	},
 	277: syscallinfo.Syscall{
 		Num:     277,
 		Name:    "mq_open",
 		Entry:   "sys_mq_open",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int oflag",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "umode_t mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct mq_attr __user *attr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
 	},
 	278: syscallinfo.Syscall{
 		Num:     278,
 		Name:    "mq_unlink",
 		Entry:   "sys_mq_unlink",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
 	},
 	279: syscallinfo.Syscall{
 		Num:     279,
 		Name:    "mq_timedsend",
 		Entry:   "sys_mq_timedsend",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "mqd_t mqdes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *msg_ptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t msg_len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int msg_prio",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct timespec __user *abs_timeout",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
 	},
 	280: syscallinfo.Syscall{
 		Num:     280,
 		Name:    "mq_timedreceive",
 		Entry:   "sys_mq_timedreceive",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "mqd_t mqdes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *msg_ptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t msg_len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "unsigned int __user *msg_prio",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct timespec __user *abs_timeout",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
 	},
 	281: syscallinfo.Syscall{
 		Num:     281,
 		Name:    "mq_notify",
 		Entry:   "sys_mq_notify",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "mqd_t mqdes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct sigevent __user *notification",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
 	},
 	282: syscallinfo.Syscall{
 		Num:     282,
 		Name:    "mq_getsetattr",
 		Entry:   "sys_mq_getsetattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "mqd_t mqdes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct mq_attr __user *mqstat",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct mq_attr __user *omqstat",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
 	},
 	283: syscallinfo.Syscall{
 		Num:     283,
 		Name:    "kexec_load",
 		Entry:   "sys_kexec_load",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long entry",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long nr_segments",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct kexec_segment __user *segments",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 13},
 	},
 	284: syscallinfo.Syscall{
 		Num:     284,
 		Name:    "waitid",
 		Entry:   "sys_waitid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int which",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct siginfo __user *infop",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int options",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct rusage __user *ru",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatProcess,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 9},
 	},
 	286: syscallinfo.Syscall{
 		Num:     286,
 		Name:    "add_key",
 		Entry:   "sys_add_key",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *_type",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *_description",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const void __user *_payload",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t plen",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "key_serial_t destringid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 10},
 	},
 	287: syscallinfo.Syscall{
 		Num:     287,
 		Name:    "request_key",
 		Entry:   "sys_request_key",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *_type",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *_description",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *_callout_info",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "key_serial_t destringid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 10},
 	},
 	288: syscallinfo.Syscall{
 		Num:     288,
 		Name:    "keyctl",
 		Entry:   "sys_keyctl",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int cmd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg2",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg3",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg4",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg5",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 10},
 	},
 	289: syscallinfo.Syscall{
 		Num:     289,
 		Name:    "ioprio_set",
 		Entry:   "sys_ioprio_set",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int which",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int who",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int ioprio",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 13},
 	},
 	290: syscallinfo.Syscall{
 		Num:     290,
 		Name:    "ioprio_get",
 		Entry:   "sys_ioprio_get",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int which",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int who",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 13},
 	},
 	291: syscallinfo.Syscall{
-		Num:     291,
-		Name:    "inotify_init",
-		Entry:   "sys_inotify_init",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        291,
+		Name:       "inotify_init",
+		Entry:      "sys_inotify_init",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 13},
 	},
 	292: syscallinfo.Syscall{
 		Num:     292,
 		Name:    "inotify_add_watch",
 		Entry:   "sys_inotify_add_watch",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "u32 mask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 13},
 	},
 	293: syscallinfo.Syscall{
 		Num:     293,
 		Name:    "inotify_rm_watch",
 		Entry:   "sys_inotify_rm_watch",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "__s32 wd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 13},
 	},
 	294: syscallinfo.Syscall{
 		Num:     294,
 		Name:    "migrate_pages",
 		Entry:   "sys_migrate_pages",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long maxnode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const unsigned long __user *from",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const unsigned long __user *to",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	295: syscallinfo.Syscall{
 		Num:     295,
 		Name:    "openat",
 		Entry:   "sys_openat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "umode_t mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	296: syscallinfo.Syscall{
 		Num:     296,
 		Name:    "mkdirat",
 		Entry:   "sys_mkdirat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user * pathname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "umode_t mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	297: syscallinfo.Syscall{
 		Num:     297,
 		Name:    "mknodat",
 		Entry:   "sys_mknodat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user * filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "umode_t mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned dev",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	298: syscallinfo.Syscall{
 		Num:     298,
 		Name:    "fchownat",
 		Entry:   "sys_fchownat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "uid_t user",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "gid_t group",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flag",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	299: syscallinfo.Syscall{
 		Num:     299,
 		Name:    "futimesat",
 		Entry:   "sys_futimesat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timeval __user *utimes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	300: syscallinfo.Syscall{
 		Num:     300,
 		Name:    "fstatat64",
 		Entry:   "sys_fstatat64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct stat64 __user *statbuf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flag",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc | syscallinfo.CatFstat | syscallinfo.CatStatLike,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	301: syscallinfo.Syscall{
 		Num:     301,
 		Name:    "unlinkat",
 		Entry:   "sys_unlinkat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user * pathname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flag",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	302: syscallinfo.Syscall{
 		Num:     302,
 		Name:    "renameat",
 		Entry:   "sys_renameat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int olddfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user * oldname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int newdfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user * newname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	303: syscallinfo.Syscall{
 		Num:     303,
 		Name:    "linkat",
 		Entry:   "sys_linkat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int olddfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *oldname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int newdfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *newname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	304: syscallinfo.Syscall{
 		Num:     304,
 		Name:    "symlinkat",
 		Entry:   "sys_symlinkat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user * oldname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int newdfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user * newname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	305: syscallinfo.Syscall{
 		Num:     305,
 		Name:    "readlinkat",
 		Entry:   "sys_readlinkat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int bufsiz",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	306: syscallinfo.Syscall{
 		Num:     306,
 		Name:    "fchmodat",
 		Entry:   "sys_fchmodat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user * filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "umode_t mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	307: syscallinfo.Syscall{
 		Num:     307,
 		Name:    "faccessat",
 		Entry:   "sys_faccessat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	308: syscallinfo.Syscall{
 		Num:     308,
 		Name:    "pselect6",
 		Entry:   "sys_pselect6",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int n",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "fd_set __user *inp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "fd_set __user *outp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "fd_set __user *exp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *tsp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "void __user *sig",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	309: syscallinfo.Syscall{
 		Num:     309,
 		Name:    "ppoll",
 		Entry:   "sys_ppoll",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct pollfd __user *ufds",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int nfds",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *tsp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const sigset_t __user *sigmask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t sigsetsize",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	310: syscallinfo.Syscall{
 		Num:     310,
 		Name:    "unshare",
 		Entry:   "sys_unshare",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long unshare_flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatProcess,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	311: syscallinfo.Syscall{
 		Num:     311,
 		Name:    "set_robust_list",
 		Entry:   "sys_set_robust_list",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct robust_list_head __user *head",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 17},
 	},
 	312: syscallinfo.Syscall{
 		Num:     312,
 		Name:    "get_robust_list",
 		Entry:   "sys_get_robust_list",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 2,
 				Sig:      "struct robust_list_head __user * __user *head_ptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "size_t __user *len_ptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 17},
 	},
 	313: syscallinfo.Syscall{
 		Num:     313,
 		Name:    "splice",
 		Entry:   "sys_splice",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd_in",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "loff_t __user *off_in",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int fd_out",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "loff_t __user *off_out",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 17},
 	},
 	314: syscallinfo.Syscall{
 		Num:     314,
 		Name:    "sync_file_range",
 		Entry:   "sys_sync_file_range",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "loff_t offset",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "loff_t nbytes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 17},
 	},
 	315: syscallinfo.Syscall{
 		Num:     315,
 		Name:    "tee",
 		Entry:   "sys_tee",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fdin",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int fdout",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 17},
 	},
 	316: syscallinfo.Syscall{
 		Num:     316,
 		Name:    "vmsplice",
 		Entry:   "sys_vmsplice",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct iovec __user *iov",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long nr_segs",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 17},
 	},
 	317: syscallinfo.Syscall{
 		Num:     317,
 		Name:    "move_pages",
 		Entry:   "sys_move_pages",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long nr_pages",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 2,
 				Sig:      "const void __user * __user *pages",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const int __user *nodes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "int __user *status",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 18},
 	},
 	318: syscallinfo.Syscall{
 		Num:     318,
 		Name:    "getcpu",
 		Entry:   "sys_getcpu",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "unsigned __user *cpu",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "unsigned __user *node",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct getcpu_cache __user *cache",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 19},
 	},
 	319: syscallinfo.Syscall{
 		Num:     319,
 		Name:    "epoll_pwait",
 		Entry:   "sys_epoll_pwait",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int epfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct epoll_event __user *events",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int maxevents",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int timeout",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const sigset_t __user *sigmask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t sigsetsize",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 19},
 	},
 	320: syscallinfo.Syscall{
 		Num:     320,
 		Name:    "utimensat",
 		Entry:   "sys_utimensat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *utimes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 22},
 	},
 	321: syscallinfo.Syscall{
 		Num:     321,
 		Name:    "signalfd",
 		Entry:   "sys_signalfd",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int ufd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "sigset_t __user *user_mask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t sizemask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatSignal,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 22},
 	},
 	322: syscallinfo.Syscall{
 		Num:     322,
 		Name:    "timerfd_create",
 		Entry:   "sys_timerfd_create",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int clockid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 25},
 	},
 	323: syscallinfo.Syscall{
 		Num:     323,
 		Name:    "eventfd",
 		Entry:   "sys_eventfd",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 22},
 	},
 	324: syscallinfo.Syscall{
 		Num:     324,
 		Name:    "fallocate",
 		Entry:   "sys_fallocate",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "loff_t offset",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "loff_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 23},
 	},
 	325: syscallinfo.Syscall{
 		Num:     325,
 		Name:    "timerfd_settime",
 		Entry:   "sys_timerfd_settime",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int ufd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct itimerspec __user *utmr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct itimerspec __user *otmr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 25},
 	},
 	326: syscallinfo.Syscall{
 		Num:     326,
 		Name:    "timerfd_gettime",
 		Entry:   "sys_timerfd_gettime",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int ufd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct itimerspec __user *otmr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 25},
 	},
 	327: syscallinfo.Syscall{
 		Num:     327,
 		Name:    "signalfd4",
 		Entry:   "sys_signalfd4",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int ufd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "sigset_t __user *user_mask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t sizemask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatSignal,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 27},
 	},
 	328: syscallinfo.Syscall{
 		Num:     328,
 		Name:    "eventfd2",
 		Entry:   "sys_eventfd2",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 27},
 	},
 	329: syscallinfo.Syscall{
 		Num:     329,
 		Name:    "epoll_create1",
 		Entry:   "sys_epoll_create1",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 27},
 	},
 	330: syscallinfo.Syscall{
 		Num:     330,
 		Name:    "dup3",
 		Entry:   "sys_dup3",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int oldfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int newfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 27},
 	},
 	331: syscallinfo.Syscall{
 		Num:     331,
 		Name:    "pipe2",
 		Entry:   "sys_pipe2",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "int __user *fildes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 27},
 	},
 	332: syscallinfo.Syscall{
 		Num:     332,
 		Name:    "inotify_init1",
 		Entry:   "sys_inotify_init1",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 27},
 	},
 	333: syscallinfo.Syscall{
 		Num:     333,
 		Name:    "preadv",
 		Entry:   "sys_preadv",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct iovec __user *vec",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long vlen",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long pos_l",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long pos_h",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 30},
 	},
 	334: syscallinfo.Syscall{
 		Num:     334,
 		Name:    "pwritev",
 		Entry:   "sys_pwritev",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct iovec __user *vec",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long vlen",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long pos_l",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long pos_h",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 30},
 	},
 	335: syscallinfo.Syscall{
 		Num:     335,
 		Name:    "rt_tgsigqueueinfo",
 		Entry:   "sys_rt_tgsigqueueinfo",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t tgid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int sig",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "siginfo_t __user *uinfo",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 31},
 	},
 	336: syscallinfo.Syscall{
 		Num:     336,
 		Name:    "perf_event_open",
 		Entry:   "sys_perf_event_open",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct perf_event_attr __user *attr_uptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int cpu",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int group_fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 31},
 	},
 	337: syscallinfo.Syscall{
 		Num:     337,
 		Name:    "recvmmsg",
 		Entry:   "sys_recvmmsg",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct mmsghdr __user *msg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int vlen",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *timeout",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 33},
 	},
 	338: syscallinfo.Syscall{
 		Num:     338,
 		Name:    "fanotify_init",
 		Entry:   "sys_fanotify_init",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int event_f_flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 37},
 	},
 	339: syscallinfo.Syscall{
 		Num:     339,
 		Name:    "fanotify_mark",
 		Entry:   "sys_fanotify_mark",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fanotify_fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "u64 mask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *pathname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 37},
 	},
 	340: syscallinfo.Syscall{
 		Num:     340,
 		Name:    "prlimit64",
 		Entry:   "sys_prlimit64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int resource",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct rlimit64 __user *new_rlim",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct rlimit64 __user *old_rlim",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 36},
 	},
 	341: syscallinfo.Syscall{
 		Num:     341,
 		Name:    "name_to_handle_at",
 		Entry:   "sys_name_to_handle_at",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct file_handle __user *handle",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "int __user *mnt_id",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flag",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 39},
 	},
 	342: syscallinfo.Syscall{
 		Num:     342,
 		Name:    "open_by_handle_at",
 		Entry:   "sys_open_by_handle_at",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int mountdirfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct file_handle __user *handle",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 39},
 	},
 	343: syscallinfo.Syscall{
 		Num:     343,
 		Name:    "clock_adjtime",
 		Entry:   "sys_clock_adjtime",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "clockid_t which_clock",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timex __user *tx",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatClock,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 39},
 	},
 	344: syscallinfo.Syscall{
 		Num:     344,
 		Name:    "syncfs",
 		Entry:   "sys_syncfs",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 39},
 	},
 	345: syscallinfo.Syscall{
 		Num:     345,
 		Name:    "sendmmsg",
 		Entry:   "sys_sendmmsg",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct mmsghdr __user *msg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int vlen",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 0, Patch: 0},
 	},
 	346: syscallinfo.Syscall{
 		Num:     346,
 		Name:    "setns",
 		Entry:   "sys_setns",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int nstype",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 0, Patch: 0},
 	},
 	347: syscallinfo.Syscall{
 		Num:     347,
 		Name:    "process_vm_readv",
 		Entry:   "sys_process_vm_readv",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct iovec __user *lvec",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long liovcnt",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct iovec __user *rvec",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long riovcnt",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 2, Patch: 0},
 	},
 	348: syscallinfo.Syscall{
 		Num:     348,
 		Name:    "process_vm_writev",
 		Entry:   "sys_process_vm_writev",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct iovec __user *lvec",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long liovcnt",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct iovec __user *rvec",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long riovcnt",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 2, Patch: 0},
 	},
 	349: syscallinfo.Syscall{
 		Num:     349,
 		Name:    "kcmp",
 		Entry:   "sys_kcmp",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid1",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid2",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int type",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long idx1",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long idx2",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 5, Patch: 0},
 	},
 	350: syscallinfo.Syscall{
 		Num:     350,
 		Name:    "finit_module",
 		Entry:   "sys_finit_module",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *uargs",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 8, Patch: 0},
 	},
 	351: syscallinfo.Syscall{
 		Num:     351,
 		Name:    "sched_setattr",
 		Entry:   "sys_sched_setattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sched_attr __user *attr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 14, Patch: 0},
 	},
 	352: syscallinfo.Syscall{
 		Num:     352,
 		Name:    "sched_getattr",
 		Entry:   "sys_sched_getattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sched_attr __user *attr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 14, Patch: 0},
 	},
 	353: syscallinfo.Syscall{
 		Num:     353,
 		Name:    "renameat2",
 		Entry:   "sys_renameat2",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int olddfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *oldname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int newdfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *newname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 15, Patch: 0},
 	},
 	354: syscallinfo.Syscall{
 		Num:     354,
 		Name:    "seccomp",
 		Entry:   "sys_seccomp",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int op",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *uargs",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 17, Patch: 0},
 	},
 	355: syscallinfo.Syscall{
 		Num:     355,
 		Name:    "getrandom",
 		Entry:   "sys_getrandom",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "char __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 17, Patch: 0},
 	},
 	356: syscallinfo.Syscall{
 		Num:     356,
 		Name:    "memfd_create",
 		Entry:   "sys_memfd_create",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *uname_ptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 17, Patch: 0},
 	},
 	357: syscallinfo.Syscall{
 		Num:     357,
 		Name:    "bpf",
 		Entry:   "sys_bpf",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int cmd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "union bpf_attr *attr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 18, Patch: 0},
 	},
 	358: syscallinfo.Syscall{
 		Num:     358,
 		Name:    "execveat",
 		Entry:   "sys_execveat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 2,
 				Sig:      "const char __user *const __user *argv",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 2,
 				Sig:      "const char __user *const __user *envp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc | syscallinfo.CatProcess,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 19, Patch: 0},
+	},
+	359: syscallinfo.Syscall{
+		Num:     359,
+		Name:    "socket",
+		Entry:   "sys_socket",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 3, Patch: 0},
+	},
+	360: syscallinfo.Syscall{
+		Num:     360,
+		Name:    "socketpair",
+		Entry:   "sys_socketpair",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "int __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 3, Patch: 0},
+	},
+	361: syscallinfo.Syscall{
+		Num:     361,
+		Name:    "bind",
+		Entry:   "sys_bind",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct sockaddr __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 3, Patch: 0},
+	},
+	362: syscallinfo.Syscall{
+		Num:     362,
+		Name:    "connect",
+		Entry:   "sys_connect",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct sockaddr __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 3, Patch: 0},
+	},
+	363: syscallinfo.Syscall{
+		Num:     363,
+		Name:    "listen",
+		Entry:   "sys_listen",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 3, Patch: 0},
+	},
+	364: syscallinfo.Syscall{
+		Num:     364,
+		Name:    "accept4",
+		Entry:   "sys_accept4",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct sockaddr __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "int __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 3, Patch: 0},
+	},
+	365: syscallinfo.Syscall{
+		Num:     365,
+		Name:    "getsockopt",
+		Entry:   "sys_getsockopt",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int level",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int optname",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "char __user *optval",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "int __user *optlen",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 3, Patch: 0},
+	},
+	366: syscallinfo.Syscall{
+		Num:     366,
+		Name:    "setsockopt",
+		Entry:   "sys_setsockopt",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int level",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int optname",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "char __user *optval",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int optlen",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 3, Patch: 0},
+	},
+	367: syscallinfo.Syscall{
+		Num:     367,
+		Name:    "getsockname",
+		Entry:   "sys_getsockname",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct sockaddr __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "int __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 3, Patch: 0},
+	},
+	368: syscallinfo.Syscall{
+		Num:     368,
+		Name:    "getpeername",
+		Entry:   "sys_getpeername",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct sockaddr __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "int __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 3, Patch: 0},
+	},
+	369: syscallinfo.Syscall{
+		Num:     369,
+		Name:    "sendto",
+		Entry:   "sys_sendto",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "void __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct sockaddr __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 3, Patch: 0},
+	},
+	370: syscallinfo.Syscall{
+		Num:     370,
+		Name:    "sendmsg",
+		Entry:   "sys_sendmsg",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct user_msghdr __user *msg",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 3, Patch: 0},
+	},
+	371: syscallinfo.Syscall{
+		Num:     371,
+		Name:    "recvfrom",
+		Entry:   "sys_recvfrom",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "void __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct sockaddr __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "int __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 3, Patch: 0},
+	},
+	372: syscallinfo.Syscall{
+		Num:     372,
+		Name:    "recvmsg",
+		Entry:   "sys_recvmsg",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct user_msghdr __user *msg",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 3, Patch: 0},
+	},
+	373: syscallinfo.Syscall{
+		Num:     373,
+		Name:    "shutdown",
+		Entry:   "sys_shutdown",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 3, Patch: 0},
+	},
+	374: syscallinfo.Syscall{
+		Num:     374,
+		Name:    "userfaultfd",
+		Entry:   "sys_userfaultfd",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 3, Patch: 0},
+	},
+	375: syscallinfo.Syscall{
+		Num:     375,
+		Name:    "membarrier",
+		Entry:   "sys_membarrier",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int cmd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int cpu_id",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 3, Patch: 0},
+	},
+	376: syscallinfo.Syscall{
+		Num:     376,
+		Name:    "mlock2",
+		Entry:   "sys_mlock2",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long start",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 4, Patch: 0},
+	},
+	377: syscallinfo.Syscall{
+		Num:     377,
+		Name:    "copy_file_range",
+		Entry:   "sys_copy_file_range",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd_in",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "loff_t __user *off_in",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int fd_out",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "loff_t __user *off_out",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 5, Patch: 0},
+	},
+	378: syscallinfo.Syscall{
+		Num:     378,
+		Name:    "preadv2",
+		Entry:   "sys_preadv2",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct iovec __user *vec",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long vlen",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long pos_l",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long pos_h",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "rwf_t flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 6, Patch: 0},
+	},
+	379: syscallinfo.Syscall{
+		Num:     379,
+		Name:    "pwritev2",
+		Entry:   "sys_pwritev2",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct iovec __user *vec",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long vlen",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long pos_l",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long pos_h",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "rwf_t flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 6, Patch: 0},
+	},
+	380: syscallinfo.Syscall{
+		Num:     380,
+		Name:    "pkey_mprotect",
+		Entry:   "sys_pkey_mprotect",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long start",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long prot",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int pkey",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 9, Patch: 0},
+	},
+	381: syscallinfo.Syscall{
+		Num:     381,
+		Name:    "pkey_alloc",
+		Entry:   "sys_pkey_alloc",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long init_val",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 9, Patch: 0},
+	},
+	382: syscallinfo.Syscall{
+		Num:     382,
+		Name:    "pkey_free",
+		Entry:   "sys_pkey_free",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int pkey",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 9, Patch: 0},
+	},
+	383: syscallinfo.Syscall{
+		Num:     383,
+		Name:    "statx",
+		Entry:   "sys_statx",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned mask",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct statx __user *buffer",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc | syscallinfo.CatStatLike,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 11, Patch: 0},
+	},
+	384: syscallinfo.Syscall{
+		Num:     384,
+		Name:    "arch_prctl",
+		Entry:   "sys_arch_prctl",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 12, Patch: 0},
+	},
+	385: syscallinfo.Syscall{
+		Num:     385,
+		Name:    "io_pgetevents",
+		Entry:   "sys_io_pgetevents",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "aio_context_t ctx_id",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "long min_nr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "long nr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct io_event __user *events",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __kernel_timespec __user *timeout",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct __aio_sigset __user *sig",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 18, Patch: 0},
+	},
+	386: syscallinfo.Syscall{
+		Num:     386,
+		Name:    "rseq",
+		Entry:   "sys_rseq",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct rseq __user *rseq",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "u32 rseq_len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "u32 sig",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 18, Patch: 0},
+	},
+	393: syscallinfo.Syscall{
+		Num:     393,
+		Name:    "semget",
+		Entry:   "sys_semget",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "key_t key",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int nsems",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int semflg",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatIPC,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	394: syscallinfo.Syscall{
+		Num:     394,
+		Name:    "semctl",
+		Entry:   "sys_semctl",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int semid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int semnum",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int cmd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long arg",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatIPC,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	395: syscallinfo.Syscall{
+		Num:     395,
+		Name:    "shmget",
+		Entry:   "sys_shmget",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "key_t key",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t size",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flag",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatIPC,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	396: syscallinfo.Syscall{
+		Num:     396,
+		Name:    "shmctl",
+		Entry:   "sys_shmctl",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int shmid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int cmd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct shmid_ds __user *buf",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatIPC,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	397: syscallinfo.Syscall{
+		Num:     397,
+		Name:    "shmat",
+		Entry:   "sys_shmat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int shmid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "char __user *shmaddr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int shmflg",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatIPC | syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	398: syscallinfo.Syscall{
+		Num:     398,
+		Name:    "shmdt",
+		Entry:   "sys_shmdt",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "char __user *shmaddr",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatIPC | syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	399: syscallinfo.Syscall{
+		Num:     399,
+		Name:    "msgget",
+		Entry:   "sys_msgget",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "key_t key",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int msgflg",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatIPC,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	400: syscallinfo.Syscall{
+		Num:     400,
+		Name:    "msgsnd",
+		Entry:   "sys_msgsnd",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int msqid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct msgbuf __user *msgp",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t msgsz",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int msgflg",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatIPC,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	401: syscallinfo.Syscall{
+		Num:     401,
+		Name:    "msgrcv",
+		Entry:   "sys_msgrcv",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int msqid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct msgbuf __user *msgp",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t msgsz",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "long msgtyp",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int msgflg",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatIPC,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	402: syscallinfo.Syscall{
+		Num:     402,
+		Name:    "msgctl",
+		Entry:   "sys_msgctl",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int msqid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int cmd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct msqid_ds __user *buf",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatIPC,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	403: syscallinfo.Syscall{
+		Num:     403,
+		Name:    "clock_gettime64",
+		Entry:   "sys_clock_gettime",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "clockid_t which_clock",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __kernel_timespec __user *tp",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatClock,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	404: syscallinfo.Syscall{
+		Num:     404,
+		Name:    "clock_settime64",
+		Entry:   "sys_clock_settime",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "clockid_t which_clock",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct __kernel_timespec __user *tp",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatClock,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	405: syscallinfo.Syscall{
+		Num:     405,
+		Name:    "clock_adjtime64",
+		Entry:   "sys_clock_adjtime",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "clockid_t which_clock",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __kernel_timex __user *tx",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatClock,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	406: syscallinfo.Syscall{
+		Num:     406,
+		Name:    "clock_getres_time64",
+		Entry:   "sys_clock_getres",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "clockid_t which_clock",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __kernel_timespec __user *tp",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatClock,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	407: syscallinfo.Syscall{
+		Num:     407,
+		Name:    "clock_nanosleep_time64",
+		Entry:   "sys_clock_nanosleep",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "clockid_t which_clock",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct __kernel_timespec __user *rqtp",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __kernel_timespec __user *rmtp",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	408: syscallinfo.Syscall{
+		Num:     408,
+		Name:    "timer_gettime64",
+		Entry:   "sys_timer_gettime",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "timer_t timer_id",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __kernel_itimerspec __user *setting",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	409: syscallinfo.Syscall{
+		Num:     409,
+		Name:    "timer_settime64",
+		Entry:   "sys_timer_settime",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "timer_t timer_id",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct __kernel_itimerspec __user *new_setting",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __kernel_itimerspec __user *old_setting",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	410: syscallinfo.Syscall{
+		Num:     410,
+		Name:    "timerfd_gettime64",
+		Entry:   "sys_timerfd_gettime",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int ufd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __kernel_itimerspec __user *otmr",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	411: syscallinfo.Syscall{
+		Num:     411,
+		Name:    "timerfd_settime64",
+		Entry:   "sys_timerfd_settime",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int ufd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct __kernel_itimerspec __user *utmr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __kernel_itimerspec __user *otmr",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	412: syscallinfo.Syscall{
+		Num:     412,
+		Name:    "utimensat_time64",
+		Entry:   "sys_utimensat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __kernel_timespec __user *utimes",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	413: syscallinfo.Syscall{
+		Num:     413,
+		Name:    "pselect6_time64",
+		Entry:   "sys_pselect6",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int n",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "fd_set __user *inp",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "fd_set __user *outp",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "fd_set __user *exp",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __kernel_timespec __user *tsp",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "void __user *sig",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	414: syscallinfo.Syscall{
+		Num:     414,
+		Name:    "ppoll_time64",
+		Entry:   "sys_ppoll",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct pollfd __user *ufds",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int nfds",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __kernel_timespec __user *tsp",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const sigset_t __user *sigmask",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t sigsetsize",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	416: syscallinfo.Syscall{
+		Num:     416,
+		Name:    "io_pgetevents_time64",
+		Entry:   "sys_io_pgetevents",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "aio_context_t ctx_id",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "long min_nr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "long nr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct io_event __user *events",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __kernel_timespec __user *timeout",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct __aio_sigset __user *sig",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	417: syscallinfo.Syscall{
+		Num:     417,
+		Name:    "recvmmsg_time64",
+		Entry:   "sys_recvmmsg",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct mmsghdr __user *msg",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int vlen",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __kernel_timespec __user *timeout",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	418: syscallinfo.Syscall{
+		Num:     418,
+		Name:    "mq_timedsend_time64",
+		Entry:   "sys_mq_timedsend",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "mqd_t mqdes",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *msg_ptr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t msg_len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int msg_prio",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct __kernel_timespec __user *abs_timeout",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	419: syscallinfo.Syscall{
+		Num:     419,
+		Name:    "mq_timedreceive_time64",
+		Entry:   "sys_mq_timedreceive",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "mqd_t mqdes",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "char __user *msg_ptr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t msg_len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "unsigned int __user *msg_prio",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct __kernel_timespec __user *abs_timeout",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	420: syscallinfo.Syscall{
+		Num:     420,
+		Name:    "semtimedop_time64",
+		Entry:   "sys_semtimedop",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int semid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct sembuf __user *sops",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned nsops",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct __kernel_timespec __user *timeout",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatIPC,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	421: syscallinfo.Syscall{
+		Num:     421,
+		Name:    "rt_sigtimedwait_time64",
+		Entry:   "sys_rt_sigtimedwait",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const sigset_t __user *uthese",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "siginfo_t __user *uinfo",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct __kernel_timespec __user *uts",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t sigsetsize",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	422: syscallinfo.Syscall{
+		Num:     422,
+		Name:    "futex_time64",
+		Entry:   "sys_futex",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "u32 __user *uaddr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int op",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "u32 val",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __kernel_timespec __user *utime",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "u32 __user *uaddr2",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "u32 val3",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	423: syscallinfo.Syscall{
+		Num:     423,
+		Name:    "sched_rr_get_interval_time64",
+		Entry:   "sys_sched_rr_get_interval",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __kernel_timespec __user *interval",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	424: syscallinfo.Syscall{
+		Num:     424,
+		Name:    "pidfd_send_signal",
+		Entry:   "sys_pidfd_send_signal",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int pidfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int sig",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "siginfo_t __user *info",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatSignal,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	425: syscallinfo.Syscall{
+		Num:     425,
+		Name:    "io_uring_setup",
+		Entry:   "sys_io_uring_setup",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "u32 entries",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct io_uring_params __user *p",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	426: syscallinfo.Syscall{
+		Num:     426,
+		Name:    "io_uring_enter",
+		Entry:   "sys_io_uring_enter",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "u32 to_submit",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "u32 min_complete",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "u32 flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const void __user *argp",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t argsz",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	427: syscallinfo.Syscall{
+		Num:     427,
+		Name:    "io_uring_register",
+		Entry:   "sys_io_uring_register",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int op",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "void __user *arg",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int nr_args",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
+	},
+	428: syscallinfo.Syscall{
+		Num:     428,
+		Name:    "open_tree",
+		Entry:   "sys_open_tree",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 2, Patch: 0},
+	},
+	429: syscallinfo.Syscall{
+		Num:     429,
+		Name:    "move_mount",
+		Entry:   "sys_move_mount",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int from_dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *from_path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int to_dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *to_path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int ms_flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 2, Patch: 0},
+	},
+	430: syscallinfo.Syscall{
+		Num:     430,
+		Name:    "fsopen",
+		Entry:   "sys_fsopen",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *fs_name",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 2, Patch: 0},
+	},
+	431: syscallinfo.Syscall{
+		Num:     431,
+		Name:    "fsconfig",
+		Entry:   "sys_fsconfig",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fs_fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int cmd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *key",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const void __user *value",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int aux",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 2, Patch: 0},
+	},
+	432: syscallinfo.Syscall{
+		Num:     432,
+		Name:    "fsmount",
+		Entry:   "sys_fsmount",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fs_fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int ms_flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 2, Patch: 0},
+	},
+	433: syscallinfo.Syscall{
+		Num:     433,
+		Name:    "fspick",
+		Entry:   "sys_fspick",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 2, Patch: 0},
+	},
+	434: syscallinfo.Syscall{
+		Num:     434,
+		Name:    "pidfd_open",
+		Entry:   "sys_pidfd_open",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 3, Patch: 0},
+	},
+	435: syscallinfo.Syscall{
+		Num:     435,
+		Name:    "clone3",
+		Entry:   "sys_clone3",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct clone_args __user *uargs",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t size",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatProcess,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 3, Patch: 0},
+	},
+	436: syscallinfo.Syscall{
+		Num:     436,
+		Name:    "close_range",
+		Entry:   "sys_close_range",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int max_fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 9, Patch: 0},
+	},
+	437: syscallinfo.Syscall{
+		Num:     437,
+		Name:    "openat2",
+		Entry:   "sys_openat2",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct open_how __user *how",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t size",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 6, Patch: 0},
+	},
+	438: syscallinfo.Syscall{
+		Num:     438,
+		Name:    "pidfd_getfd",
+		Entry:   "sys_pidfd_getfd",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int pidfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 6, Patch: 0},
+	},
+	439: syscallinfo.Syscall{
+		Num:     439,
+		Name:    "faccessat2",
+		Entry:   "sys_faccessat2",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int mode",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 8, Patch: 0},
+	},
+	440: syscallinfo.Syscall{
+		Num:     440,
+		Name:    "process_madvise",
+		Entry:   "sys_process_madvise",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int pidfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct iovec __user *vec",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t vlen",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int behavior",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 10, Patch: 0},
+	},
+	441: syscallinfo.Syscall{
+		Num:     441,
+		Name:    "epoll_pwait2",
+		Entry:   "sys_epoll_pwait2",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int epfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct epoll_event __user *events",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int maxevents",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct __kernel_timespec __user *timeout",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const sigset_t __user *sigmask",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t sigsetsize",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 11, Patch: 0},
+	},
+	442: syscallinfo.Syscall{
+		Num:     442,
+		Name:    "mount_setattr",
+		Entry:   "sys_mount_setattr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct mount_attr __user *uattr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t usize",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 12, Patch: 0},
+	},
+	443: syscallinfo.Syscall{
+		Num:     443,
+		Name:    "quotactl_fd",
+		Entry:   "sys_quotactl_fd",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int cmd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "qid_t id",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "void __user *addr",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 14, Patch: 0},
+	},
+	444: syscallinfo.Syscall{
+		Num:     444,
+		Name:    "landlock_create_ruleset",
+		Entry:   "sys_landlock_create_ruleset",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const struct landlock_ruleset_attr __user *attr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t size",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "__u32 flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 13, Patch: 0},
+	},
+	445: syscallinfo.Syscall{
+		Num:     445,
+		Name:    "landlock_add_rule",
+		Entry:   "sys_landlock_add_rule",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int ruleset_fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "enum landlock_rule_type rule_type",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const void __user *rule_attr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "__u32 flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 13, Patch: 0},
+	},
+	446: syscallinfo.Syscall{
+		Num:     446,
+		Name:    "landlock_restrict_self",
+		Entry:   "sys_landlock_restrict_self",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int ruleset_fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "__u32 flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 13, Patch: 0},
+	},
+	447: syscallinfo.Syscall{
+		Num:     447,
+		Name:    "memfd_secret",
+		Entry:   "sys_memfd_secret",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 14, Patch: 0},
+	},
+	448: syscallinfo.Syscall{
+		Num:     448,
+		Name:    "process_mrelease",
+		Entry:   "sys_process_mrelease",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int pidfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 15, Patch: 0},
+	},
+	449: syscallinfo.Syscall{
+		Num:     449,
+		Name:    "futex_waitv",
+		Entry:   "sys_futex_waitv",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct futex_waitv __user *waiters",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int nr_futexes",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __kernel_timespec __user *timeout",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "clockid_t clockid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 16, Patch: 0},
+	},
+	450: syscallinfo.Syscall{
+		Num:     450,
+		Name:    "set_mempolicy_home_node",
+		Entry:   "sys_set_mempolicy_home_node",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long start",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long home_node",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 17, Patch: 0},
+	},
+	451: syscallinfo.Syscall{
+		Num:     451,
+		Name:    "cachestat",
+		Entry:   "sys_cachestat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct cachestat_range __user *cstat_range",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct cachestat __user *cstat",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 5, Patch: 0},
+	},
+	452: syscallinfo.Syscall{
+		Num:     452,
+		Name:    "fchmodat2",
+		Entry:   "sys_fchmodat2",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "umode_t mode",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 6, Patch: 0},
+	},
+	454: syscallinfo.Syscall{
+		Num:     454,
+		Name:    "futex_wake",
+		Entry:   "sys_futex_wake",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "void __user *uaddr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long mask",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int nr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 7, Patch: 0},
+	},
+	455: syscallinfo.Syscall{
+		Num:     455,
+		Name:    "futex_wait",
+		Entry:   "sys_futex_wait",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "void __user *uaddr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long val",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long mask",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __kernel_timespec __user *timeout",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "clockid_t clockid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 7, Patch: 0},
+	},
+	456: syscallinfo.Syscall{
+		Num:     456,
+		Name:    "futex_requeue",
+		Entry:   "sys_futex_requeue",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct futex_waitv __user *waiters",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int nr_wake",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int nr_requeue",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 7, Patch: 0},
+	},
+	457: syscallinfo.Syscall{
+		Num:     457,
+		Name:    "statmount",
+		Entry:   "sys_statmount",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const struct mnt_id_req __user *req",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct statmount __user *buf",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t bufsize",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 8, Patch: 0},
+	},
+	458: syscallinfo.Syscall{
+		Num:     458,
+		Name:    "listmount",
+		Entry:   "sys_listmount",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const struct mnt_id_req __user *req",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "u64 __user *mnt_ids",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t nr_mnt_ids",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 8, Patch: 0},
+	},
+	459: syscallinfo.Syscall{
+		Num:     459,
+		Name:    "lsm_get_self_attr",
+		Entry:   "sys_lsm_get_self_attr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int attr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct lsm_ctx __user *ctx",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "u32 __user *size",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "u32 flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 8, Patch: 0},
+	},
+	460: syscallinfo.Syscall{
+		Num:     460,
+		Name:    "lsm_set_self_attr",
+		Entry:   "sys_lsm_set_self_attr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int attr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct lsm_ctx __user *ctx",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "u32 size",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "u32 flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 8, Patch: 0},
+	},
+	461: syscallinfo.Syscall{
+		Num:     461,
+		Name:    "lsm_list_modules",
+		Entry:   "sys_lsm_list_modules",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "u64 __user *ids",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "u32 __user *size",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "u32 flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 8, Patch: 0},
+	},
+	462: syscallinfo.Syscall{
+		Num:     462,
+		Name:    "mseal",
+		Entry:   "sys_mseal",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long start",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 10, Patch: 0},
+	},
+	463: syscallinfo.Syscall{
+		Num:     463,
+		Name:    "setxattrat",
+		Entry:   "sys_setxattrat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int at_flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct xattr_args __user *args",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t size",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 13, Patch: 0},
+	},
+	464: syscallinfo.Syscall{
+		Num:     464,
+		Name:    "getxattrat",
+		Entry:   "sys_getxattrat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int at_flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct xattr_args __user *args",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t size",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 13, Patch: 0},
+	},
+	465: syscallinfo.Syscall{
+		Num:     465,
+		Name:    "listxattrat",
+		Entry:   "sys_listxattrat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int at_flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "char __user *list",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t size",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 13, Patch: 0},
+	},
+	466: syscallinfo.Syscall{
+		Num:     466,
+		Name:    "removexattrat",
+		Entry:   "sys_removexattrat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int at_flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 13, Patch: 0},
+	},
+	467: syscallinfo.Syscall{
+		Num:     467,
+		Name:    "open_tree_attr",
+		Entry:   "sys_open_tree_attr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct mount_attr __user *uattr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t usize",
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 15, Patch: 0},
 	},
 }
@@ -0,0 +1,7511 @@
+// MACHINE GENERATED BY 'go generate' COMMAND; DO NOT EDIT
+
+//go:build !syscallinfo_embed
+// +build !syscallinfo_embed
+
+package linux_386
+
+import "github.com/jroimartin/syscallinfo"
+
+var SyscallTable4_0 = syscallinfo.SyscallTable{
+	0: syscallinfo.Syscall{
+		Num:        0,
+		Name:       "restart_syscall",
+		Entry:      "sys_restart_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+	},
+	1: syscallinfo.Syscall{
+		Num:     1,
+		Name:    "exit",
+		Entry:   "sys_exit",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int error_code",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatProcess,
+	},
+	2: syscallinfo.Syscall{
+		Num:        2,
+		Name:       "fork",
+		Entry:      "sys_fork",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatProcess,
+	},
+	3: syscallinfo.Syscall{
+		Num:     3,
+		Name:    "read",
+		Entry:   "sys_read",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxFD,
+			},
+			{
+				RefCount: 1,
+				Sig:      "char __user *buf",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t count",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	4: syscallinfo.Syscall{
+		Num:     4,
+		Name:    "write",
+		Entry:   "sys_write",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *buf",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t count",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	5: syscallinfo.Syscall{
+		Num:     5,
+		Name:    "open",
+		Entry:   "sys_open",
+		Context: syscallinfo.CtxFD,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "umode_t mode",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+	},
+	6: syscallinfo.Syscall{
+		Num:     6,
+		Name:    "close",
+		Entry:   "sys_close",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	7: syscallinfo.Syscall{
+		Num:     7,
+		Name:    "waitpid",
+		Entry:   "sys_waitpid",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "int __user *stat_addr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int options",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatProcess,
+	},
+	8: syscallinfo.Syscall{
+		Num:     8,
+		Name:    "creat",
+		Entry:   "sys_creat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *pathname",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "umode_t mode",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+	},
+	9: syscallinfo.Syscall{
+		Num:     9,
+		Name:    "link",
+		Entry:   "sys_link",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *oldname",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *newname",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	10: syscallinfo.Syscall{
+		Num:     10,
+		Name:    "unlink",
+		Entry:   "sys_unlink",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *pathname",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	11: syscallinfo.Syscall{
+		Num:     11,
+		Name:    "execve",
+		Entry:   "sys_execve",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 2,
+				Sig:      "const char __user *const __user *argv",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 2,
+				Sig:      "const char __user *const __user *envp",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatProcess,
+	},
+	12: syscallinfo.Syscall{
+		Num:     12,
+		Name:    "chdir",
+		Entry:   "sys_chdir",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	13: syscallinfo.Syscall{
+		Num:     13,
+		Name:    "time",
+		Entry:   "sys_time",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "time_t __user *tloc",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatClock,
+	},
+	14: syscallinfo.Syscall{
+		Num:     14,
+		Name:    "mknod",
+		Entry:   "sys_mknod",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "umode_t mode",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned dev",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	15: syscallinfo.Syscall{
+		Num:     15,
+		Name:    "chmod",
+		Entry:   "sys_chmod",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "umode_t mode",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	16: syscallinfo.Syscall{
+		Num:     16,
+		Name:    "lchown",
+		Entry:   "sys_lchown16",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "old_uid_t user",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "old_gid_t group",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	17: syscallinfo.Syscall{
+		Num:        17,
+		Name:       "break",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	18: syscallinfo.Syscall{
+		Num:     18,
+		Name:    "oldstat",
+		Entry:   "sys_stat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __old_kernel_stat __user *statbuf",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatStat | syscallinfo.CatStatLike,
+	},
+	19: syscallinfo.Syscall{
+		Num:     19,
+		Name:    "lseek",
+		Entry:   "sys_lseek",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "off_t offset",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int whence",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	20: syscallinfo.Syscall{
+		Num:        20,
+		Name:       "getpid",
+		Entry:      "sys_getpid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatPure,
+	},
+	21: syscallinfo.Syscall{
+		Num:     21,
+		Name:    "mount",
+		Entry:   "sys_mount",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "char __user *dev_name",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "char __user *dir_name",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "char __user *type",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "void __user *data",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	22: syscallinfo.Syscall{
+		Num:     22,
+		Name:    "umount",
+		Entry:   "sys_oldumount",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	23: syscallinfo.Syscall{
+		Num:     23,
+		Name:    "setuid",
+		Entry:   "sys_setuid16",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "old_uid_t uid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	24: syscallinfo.Syscall{
+		Num:        24,
+		Name:       "getuid",
+		Entry:      "sys_getuid16",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatCreds | syscallinfo.CatPure,
+	},
+	25: syscallinfo.Syscall{
+		Num:     25,
+		Name:    "stime",
+		Entry:   "sys_stime",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "time_t __user *tptr",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatClock,
+	},
+	26: syscallinfo.Syscall{
+		Num:     26,
+		Name:    "ptrace",
+		Entry:   "sys_ptrace",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "long request",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "long pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long addr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long data",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	27: syscallinfo.Syscall{
+		Num:     27,
+		Name:    "alarm",
+		Entry:   "sys_alarm",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int seconds",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	28: syscallinfo.Syscall{
+		Num:     28,
+		Name:    "oldfstat",
+		Entry:   "sys_fstat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __old_kernel_stat __user *statbuf",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFstat | syscallinfo.CatStatLike,
+	},
+	29: syscallinfo.Syscall{
+		Num:        29,
+		Name:       "pause",
+		Entry:      "sys_pause",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatSignal,
+	},
+	30: syscallinfo.Syscall{
+		Num:     30,
+		Name:    "utime",
+		Entry:   "sys_utime",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct utimbuf __user *times",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	31: syscallinfo.Syscall{
+		Num:        31,
+		Name:       "stty",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	32: syscallinfo.Syscall{
+		Num:        32,
+		Name:       "gtty",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	33: syscallinfo.Syscall{
+		Num:     33,
+		Name:    "access",
+		Entry:   "sys_access",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int mode",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	34: syscallinfo.Syscall{
+		Num:     34,
+		Name:    "nice",
+		Entry:   "sys_nice",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int increment",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	35: syscallinfo.Syscall{
+		Num:        35,
+		Name:       "ftime",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	36: syscallinfo.Syscall{
+		Num:        36,
+		Name:       "sync",
+		Entry:      "sys_sync",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+	},
+	37: syscallinfo.Syscall{
+		Num:     37,
+		Name:    "kill",
+		Entry:   "sys_kill",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int sig",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+	},
+	38: syscallinfo.Syscall{
+		Num:     38,
+		Name:    "rename",
+		Entry:   "sys_rename",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *oldname",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *newname",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	39: syscallinfo.Syscall{
+		Num:     39,
+		Name:    "mkdir",
+		Entry:   "sys_mkdir",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *pathname",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "umode_t mode",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	40: syscallinfo.Syscall{
+		Num:     40,
+		Name:    "rmdir",
+		Entry:   "sys_rmdir",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *pathname",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	41: syscallinfo.Syscall{
+		Num:     41,
+		Name:    "dup",
+		Entry:   "sys_dup",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fildes",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	42: syscallinfo.Syscall{
+		Num:     42,
+		Name:    "pipe",
+		Entry:   "sys_pipe",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "int __user *fildes",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	43: syscallinfo.Syscall{
+		Num:     43,
+		Name:    "times",
+		Entry:   "sys_times",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct tms __user *tbuf",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	44: syscallinfo.Syscall{
+		Num:        44,
+		Name:       "prof",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	45: syscallinfo.Syscall{
+		Num:     45,
+		Name:    "brk",
+		Entry:   "sys_brk",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long brk",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+	},
+	46: syscallinfo.Syscall{
+		Num:     46,
+		Name:    "setgid",
+		Entry:   "sys_setgid16",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "old_gid_t gid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	47: syscallinfo.Syscall{
+		Num:        47,
+		Name:       "getgid",
+		Entry:      "sys_getgid16",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatCreds | syscallinfo.CatPure,
+	},
+	48: syscallinfo.Syscall{
+		Num:     48,
+		Name:    "signal",
+		Entry:   "sys_signal",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int sig",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "__sighandler_t handler",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+	},
+	49: syscallinfo.Syscall{
+		Num:        49,
+		Name:       "geteuid",
+		Entry:      "sys_geteuid16",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatCreds | syscallinfo.CatPure,
+	},
+	50: syscallinfo.Syscall{
+		Num:        50,
+		Name:       "getegid",
+		Entry:      "sys_getegid16",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatCreds | syscallinfo.CatPure,
+	},
+	51: syscallinfo.Syscall{
+		Num:     51,
+		Name:    "acct",
+		Entry:   "sys_acct",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	52: syscallinfo.Syscall{
+		Num:     52,
+		Name:    "umount2",
+		Entry:   "sys_umount",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	53: syscallinfo.Syscall{
+		Num:        53,
+		Name:       "lock",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	54: syscallinfo.Syscall{
+		Num:     54,
+		Name:    "ioctl",
+		Entry:   "sys_ioctl",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int cmd",
+				Context:  syscallinfo.CtxIoctlReq,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long arg",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	55: syscallinfo.Syscall{
+		Num:     55,
+		Name:    "fcntl",
+		Entry:   "sys_fcntl",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxFD,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int cmd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long arg",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	56: syscallinfo.Syscall{
+		Num:        56,
+		Name:       "mpx",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	57: syscallinfo.Syscall{
+		Num:     57,
+		Name:    "setpgid",
+		Entry:   "sys_setpgid",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "pid_t pgid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	58: syscallinfo.Syscall{
+		Num:        58,
+		Name:       "ulimit",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	59: syscallinfo.Syscall{
+		Num:     59,
+		Name:    "oldolduname",
+		Entry:   "sys_olduname",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct oldold_utsname __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	60: syscallinfo.Syscall{
+		Num:     60,
+		Name:    "umask",
+		Entry:   "sys_umask",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int mask",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	61: syscallinfo.Syscall{
+		Num:     61,
+		Name:    "chroot",
+		Entry:   "sys_chroot",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	62: syscallinfo.Syscall{
+		Num:     62,
+		Name:    "ustat",
+		Entry:   "sys_ustat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned dev",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct ustat __user *ubuf",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatStatfsLike,
+	},
+	63: syscallinfo.Syscall{
+		Num:     63,
+		Name:    "dup2",
+		Entry:   "sys_dup2",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int oldfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int newfd",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	64: syscallinfo.Syscall{
+		Num:        64,
+		Name:       "getppid",
+		Entry:      "sys_getppid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatPure,
+	},
+	65: syscallinfo.Syscall{
+		Num:        65,
+		Name:       "getpgrp",
+		Entry:      "sys_getpgrp",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatPure,
+	},
+	66: syscallinfo.Syscall{
+		Num:        66,
+		Name:       "setsid",
+		Entry:      "sys_setsid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+	},
+	67: syscallinfo.Syscall{
+		Num:     67,
+		Name:    "sigaction",
+		Entry:   "sys_sigaction",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct old_sigaction __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct old_sigaction __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+	},
+	68: syscallinfo.Syscall{
+		Num:        68,
+		Name:       "sgetmask",
+		Entry:      "sys_sgetmask",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatSignal,
+	},
+	69: syscallinfo.Syscall{
+		Num:     69,
+		Name:    "ssetmask",
+		Entry:   "sys_ssetmask",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int newmask",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+	},
+	70: syscallinfo.Syscall{
+		Num:     70,
+		Name:    "setreuid",
+		Entry:   "sys_setreuid16",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "old_uid_t ruid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "old_uid_t euid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	71: syscallinfo.Syscall{
+		Num:     71,
+		Name:    "setregid",
+		Entry:   "sys_setregid16",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "old_gid_t rgid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "old_gid_t egid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	72: syscallinfo.Syscall{
+		Num:     72,
+		Name:    "sigsuspend",
+		Entry:   "sys_sigsuspend",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int unused1",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int unused2",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "old_sigset_t mask",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+	},
+	73: syscallinfo.Syscall{
+		Num:     73,
+		Name:    "sigpending",
+		Entry:   "sys_sigpending",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "old_sigset_t __user *set",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+	},
+	74: syscallinfo.Syscall{
+		Num:     74,
+		Name:    "sethostname",
+		Entry:   "sys_sethostname",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int len",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	75: syscallinfo.Syscall{
+		Num:     75,
+		Name:    "setrlimit",
+		Entry:   "sys_setrlimit",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int resource",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct rlimit __user *rlim",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	76: syscallinfo.Syscall{
+		Num:     76,
+		Name:    "getrlimit",
+		Entry:   "sys_old_getrlimit",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int resource",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct rlimit __user *rlim",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	77: syscallinfo.Syscall{
+		Num:     77,
+		Name:    "getrusage",
+		Entry:   "sys_getrusage",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int who",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct rusage __user *ru",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	78: syscallinfo.Syscall{
+		Num:     78,
+		Name:    "gettimeofday",
+		Entry:   "sys_gettimeofday",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct timeval __user *tv",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct timezone __user *tz",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatClock,
+	},
+	79: syscallinfo.Syscall{
+		Num:     79,
+		Name:    "settimeofday",
+		Entry:   "sys_settimeofday",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct timeval __user *tv",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct timezone __user *tz",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatClock,
+	},
+	80: syscallinfo.Syscall{
+		Num:     80,
+		Name:    "getgroups",
+		Entry:   "sys_getgroups16",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int gidsetsize",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "old_gid_t __user *grouplist",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	81: syscallinfo.Syscall{
+		Num:     81,
+		Name:    "setgroups",
+		Entry:   "sys_setgroups16",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int gidsetsize",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "old_gid_t __user *grouplist",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	82: syscallinfo.Syscall{
+		Num:     82,
+		Name:    "select",
+		Entry:   "sys_old_select",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct sel_arg_struct __user *arg",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	83: syscallinfo.Syscall{
+		Num:     83,
+		Name:    "symlink",
+		Entry:   "sys_symlink",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *old",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *new",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	84: syscallinfo.Syscall{
+		Num:     84,
+		Name:    "oldlstat",
+		Entry:   "sys_lstat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __old_kernel_stat __user *statbuf",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatLstat | syscallinfo.CatStatLike,
+	},
+	85: syscallinfo.Syscall{
+		Num:     85,
+		Name:    "readlink",
+		Entry:   "sys_readlink",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "char __user *buf",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int bufsiz",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	86: syscallinfo.Syscall{
+		Num:     86,
+		Name:    "uselib",
+		Entry:   "sys_uselib",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *library",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	87: syscallinfo.Syscall{
+		Num:     87,
+		Name:    "swapon",
+		Entry:   "sys_swapon",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *specialfile",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int swap_flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	88: syscallinfo.Syscall{
+		Num:     88,
+		Name:    "reboot",
+		Entry:   "sys_reboot",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int magic1",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int magic2",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int cmd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "void __user *arg",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	89: syscallinfo.Syscall{
+		Num:     89,
+		Name:    "readdir",
+		Entry:   "sys_old_readdir",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct old_linux_dirent __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	90: syscallinfo.Syscall{
+		Num:     90,
+		Name:    "mmap",
+		Entry:   "sys_old_mmap",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct mmap_arg_struct __user *arg",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatMemory,
+	},
+	91: syscallinfo.Syscall{
+		Num:     91,
+		Name:    "munmap",
+		Entry:   "sys_munmap",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long addr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t len",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+	},
+	92: syscallinfo.Syscall{
+		Num:     92,
+		Name:    "truncate",
+		Entry:   "sys_truncate",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "long length",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	93: syscallinfo.Syscall{
+		Num:     93,
+		Name:    "ftruncate",
+		Entry:   "sys_ftruncate",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long length",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	94: syscallinfo.Syscall{
+		Num:     94,
+		Name:    "fchmod",
+		Entry:   "sys_fchmod",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "umode_t mode",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	95: syscallinfo.Syscall{
+		Num:     95,
+		Name:    "fchown",
+		Entry:   "sys_fchown16",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "old_uid_t user",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "old_gid_t group",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	96: syscallinfo.Syscall{
+		Num:     96,
+		Name:    "getpriority",
+		Entry:   "sys_getpriority",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int which",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int who",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	97: syscallinfo.Syscall{
+		Num:     97,
+		Name:    "setpriority",
+		Entry:   "sys_setpriority",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int which",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int who",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int niceval",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	98: syscallinfo.Syscall{
+		Num:        98,
+		Name:       "profil",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	99: syscallinfo.Syscall{
+		Num:     99,
+		Name:    "statfs",
+		Entry:   "sys_statfs",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user * path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct statfs __user *buf",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatStatfs | syscallinfo.CatStatfsLike,
+	},
+	100: syscallinfo.Syscall{
+		Num:     100,
+		Name:    "fstatfs",
+		Entry:   "sys_fstatfs",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct statfs __user *buf",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatFstatfs | syscallinfo.CatStatfsLike,
+	},
+	101: syscallinfo.Syscall{
+		Num:     101,
+		Name:    "ioperm",
+		Entry:   "sys_ioperm",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	102: syscallinfo.Syscall{
+		Num:     102,
+		Name:    "socketcall",
+		Entry:   "sys_socketcall",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int call",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "unsigned long __user *args",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatNetwork,
+	},
+	103: syscallinfo.Syscall{
+		Num:     103,
+		Name:    "syslog",
+		Entry:   "sys_syslog",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int type",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "char __user *buf",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int len",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	104: syscallinfo.Syscall{
+		Num:     104,
+		Name:    "setitimer",
+		Entry:   "sys_setitimer",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int which",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct itimerval __user *value",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct itimerval __user *ovalue",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	105: syscallinfo.Syscall{
+		Num:     105,
+		Name:    "getitimer",
+		Entry:   "sys_getitimer",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int which",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct itimerval __user *value",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	106: syscallinfo.Syscall{
+		Num:     106,
+		Name:    "stat",
+		Entry:   "sys_newstat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct stat __user *statbuf",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatStat | syscallinfo.CatStatLike,
+	},
+	107: syscallinfo.Syscall{
+		Num:     107,
+		Name:    "lstat",
+		Entry:   "sys_newlstat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct stat __user *statbuf",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatLstat | syscallinfo.CatStatLike,
+	},
+	108: syscallinfo.Syscall{
+		Num:     108,
+		Name:    "fstat",
+		Entry:   "sys_newfstat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct stat __user *statbuf",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatFstat | syscallinfo.CatStatLike,
+	},
+	109: syscallinfo.Syscall{
+		Num:     109,
+		Name:    "olduname",
+		Entry:   "sys_uname",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct old_utsname __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	110: syscallinfo.Syscall{
+		Num:     110,
+		Name:    "iopl",
+		Entry:   "sys_iopl",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	111: syscallinfo.Syscall{
+		Num:        111,
+		Name:       "vhangup",
+		Entry:      "sys_vhangup",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+	},
+	112: syscallinfo.Syscall{
+		Num:        112,
+		Name:       "idle",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	113: syscallinfo.Syscall{
+		Num:     113,
+		Name:    "vm86old",
+		Entry:   "sys_vm86old",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct vm86_struct __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	114: syscallinfo.Syscall{
+		Num:     114,
+		Name:    "wait4",
+		Entry:   "sys_wait4",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "int __user *stat_addr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int options",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct rusage __user *ru",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatProcess,
+	},
+	115: syscallinfo.Syscall{
+		Num:     115,
+		Name:    "swapoff",
+		Entry:   "sys_swapoff",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *specialfile",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	116: syscallinfo.Syscall{
+		Num:     116,
+		Name:    "sysinfo",
+		Entry:   "sys_sysinfo",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct sysinfo __user *info",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	117: syscallinfo.Syscall{
+		Num:     117,
+		Name:    "ipc",
+		Entry:   "sys_ipc",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int call",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int first",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long second",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long third",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "void __user *ptr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "long fifth",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatIPC,
+	},
+	118: syscallinfo.Syscall{
+		Num:     118,
+		Name:    "fsync",
+		Entry:   "sys_fsync",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	119: syscallinfo.Syscall{
+		Num:        119,
+		Name:       "sigreturn",
+		Entry:      "sys_sigreturn",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatSignal,
+	},
+	120: syscallinfo.Syscall{
+		Num:     120,
+		Name:    "clone",
+		Entry:   "sys_clone",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "int __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "int __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatProcess,
+	},
+	121: syscallinfo.Syscall{
+		Num:     121,
+		Name:    "setdomainname",
+		Entry:   "sys_setdomainname",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int len",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	122: syscallinfo.Syscall{
+		Num:     122,
+		Name:    "uname",
+		Entry:   "sys_newuname",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct new_utsname __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	123: syscallinfo.Syscall{
+		Num:     123,
+		Name:    "modify_ldt",
+		Entry:   "sys_modify_ldt",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "void __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	124: syscallinfo.Syscall{
+		Num:     124,
+		Name:    "adjtimex",
+		Entry:   "sys_adjtimex",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct timex __user *txc_p",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatClock,
+	},
+	125: syscallinfo.Syscall{
+		Num:     125,
+		Name:    "mprotect",
+		Entry:   "sys_mprotect",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long start",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long prot",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+	},
+	126: syscallinfo.Syscall{
+		Num:     126,
+		Name:    "sigprocmask",
+		Entry:   "sys_sigprocmask",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int how",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "old_sigset_t __user *set",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "old_sigset_t __user *oset",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+	},
+	127: syscallinfo.Syscall{
+		Num:        127,
+		Name:       "create_module",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Until:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 0},
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	128: syscallinfo.Syscall{
+		Num:     128,
+		Name:    "init_module",
+		Entry:   "sys_init_module",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "void __user *umod",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *uargs",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	129: syscallinfo.Syscall{
+		Num:     129,
+		Name:    "delete_module",
+		Entry:   "sys_delete_module",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *name_user",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	130: syscallinfo.Syscall{
+		Num:        130,
+		Name:       "get_kernel_syms",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Until:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 0},
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	131: syscallinfo.Syscall{
+		Num:     131,
+		Name:    "quotactl",
+		Entry:   "sys_quotactl",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int cmd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *special",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "qid_t id",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "void __user *addr",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	132: syscallinfo.Syscall{
+		Num:     132,
+		Name:    "getpgid",
+		Entry:   "sys_getpgid",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	133: syscallinfo.Syscall{
+		Num:     133,
+		Name:    "fchdir",
+		Entry:   "sys_fchdir",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	134: syscallinfo.Syscall{
+		Num:     134,
+		Name:    "bdflush",
+		Entry:   "sys_bdflush",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int func",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "long data",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	135: syscallinfo.Syscall{
+		Num:     135,
+		Name:    "sysfs",
+		Entry:   "sys_sysfs",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int option",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long arg1",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long arg2",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	136: syscallinfo.Syscall{
+		Num:     136,
+		Name:    "personality",
+		Entry:   "sys_personality",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int personality",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	137: syscallinfo.Syscall{
+		Num:        137,
+		Name:       "afs_syscall",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	138: syscallinfo.Syscall{
+		Num:     138,
+		Name:    "setfsuid",
+		Entry:   "sys_setfsuid16",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "old_uid_t uid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	139: syscallinfo.Syscall{
+		Num:     139,
+		Name:    "setfsgid",
+		Entry:   "sys_setfsgid16",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "old_gid_t gid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	140: syscallinfo.Syscall{
+		Num:     140,
+		Name:    "_llseek",
+		Entry:   "sys_llseek",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long offset_high",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long offset_low",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "loff_t __user *result",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int whence",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	141: syscallinfo.Syscall{
+		Num:     141,
+		Name:    "getdents",
+		Entry:   "sys_getdents",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct linux_dirent __user *dirent",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int count",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	142: syscallinfo.Syscall{
+		Num:     142,
+		Name:    "_newselect",
+		Entry:   "sys_select",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int n",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "fd_set __user *inp",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "fd_set __user *outp",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "fd_set __user *exp",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct timeval __user *tvp",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	143: syscallinfo.Syscall{
+		Num:     143,
+		Name:    "flock",
+		Entry:   "sys_flock",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int cmd",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	144: syscallinfo.Syscall{
+		Num:     144,
+		Name:    "msync",
+		Entry:   "sys_msync",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long start",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+	},
+	145: syscallinfo.Syscall{
+		Num:     145,
+		Name:    "readv",
+		Entry:   "sys_readv",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct iovec __user *vec",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long vlen",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	146: syscallinfo.Syscall{
+		Num:     146,
+		Name:    "writev",
+		Entry:   "sys_writev",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct iovec __user *vec",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long vlen",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	147: syscallinfo.Syscall{
+		Num:     147,
+		Name:    "getsid",
+		Entry:   "sys_getsid",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	148: syscallinfo.Syscall{
+		Num:     148,
+		Name:    "fdatasync",
+		Entry:   "sys_fdatasync",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	149: syscallinfo.Syscall{
+		Num:     149,
+		Name:    "_sysctl",
+		Entry:   "sys_sysctl",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct __sysctl_args __user *args",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Until:      syscallinfo.KernelVersion{Major: 5, Minor: 5, Patch: 0},
+	},
+	150: syscallinfo.Syscall{
+		Num:     150,
+		Name:    "mlock",
+		Entry:   "sys_mlock",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long start",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t len",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+	},
+	151: syscallinfo.Syscall{
+		Num:     151,
+		Name:    "munlock",
+		Entry:   "sys_munlock",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long start",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t len",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+	},
+	152: syscallinfo.Syscall{
+		Num:     152,
+		Name:    "mlockall",
+		Entry:   "sys_mlockall",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+	},
+	153: syscallinfo.Syscall{
+		Num:        153,
+		Name:       "munlockall",
+		Entry:      "sys_munlockall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatMemory,
+	},
+	154: syscallinfo.Syscall{
+		Num:     154,
+		Name:    "sched_setparam",
+		Entry:   "sys_sched_setparam",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct sched_param __user *param",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	155: syscallinfo.Syscall{
+		Num:     155,
+		Name:    "sched_getparam",
+		Entry:   "sys_sched_getparam",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct sched_param __user *param",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	156: syscallinfo.Syscall{
+		Num:     156,
+		Name:    "sched_setscheduler",
+		Entry:   "sys_sched_setscheduler",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int policy",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct sched_param __user *param",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	157: syscallinfo.Syscall{
+		Num:     157,
+		Name:    "sched_getscheduler",
+		Entry:   "sys_sched_getscheduler",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	158: syscallinfo.Syscall{
+		Num:        158,
+		Name:       "sched_yield",
+		Entry:      "sys_sched_yield",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+	},
+	159: syscallinfo.Syscall{
+		Num:     159,
+		Name:    "sched_get_priority_max",
+		Entry:   "sys_sched_get_priority_max",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int policy",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	160: syscallinfo.Syscall{
+		Num:     160,
+		Name:    "sched_get_priority_min",
+		Entry:   "sys_sched_get_priority_min",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int policy",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	161: syscallinfo.Syscall{
+		Num:     161,
+		Name:    "sched_rr_get_interval",
+		Entry:   "sys_sched_rr_get_interval",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct timespec __user *interval",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	162: syscallinfo.Syscall{
+		Num:     162,
+		Name:    "nanosleep",
+		Entry:   "sys_nanosleep",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct timespec __user *rqtp",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct timespec __user *rmtp",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	163: syscallinfo.Syscall{
+		Num:     163,
+		Name:    "mremap",
+		Entry:   "sys_mremap",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long addr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long old_len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long new_len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long new_addr",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+	},
+	164: syscallinfo.Syscall{
+		Num:     164,
+		Name:    "setresuid",
+		Entry:   "sys_setresuid16",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "old_uid_t ruid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "old_uid_t euid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "old_uid_t suid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	165: syscallinfo.Syscall{
+		Num:     165,
+		Name:    "getresuid",
+		Entry:   "sys_getresuid16",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "old_uid_t __user *ruid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "old_uid_t __user *euid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "old_uid_t __user *suid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	166: syscallinfo.Syscall{
+		Num:     166,
+		Name:    "vm86",
+		Entry:   "sys_vm86",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	167: syscallinfo.Syscall{
+		Num:        167,
+		Name:       "query_module",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Until:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 0},
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	168: syscallinfo.Syscall{
+		Num:     168,
+		Name:    "poll",
+		Entry:   "sys_poll",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct pollfd __user *ufds",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int nfds",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int timeout",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	169: syscallinfo.Syscall{
+		Num:        169,
+		Name:       "nfsservctl",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Until:      syscallinfo.KernelVersion{Major: 3, Minor: 1, Patch: 0},
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	170: syscallinfo.Syscall{
+		Num:     170,
+		Name:    "setresgid",
+		Entry:   "sys_setresgid16",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "old_gid_t rgid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "old_gid_t egid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "old_gid_t sgid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	171: syscallinfo.Syscall{
+		Num:     171,
+		Name:    "getresgid",
+		Entry:   "sys_getresgid16",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "old_gid_t __user *rgid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "old_gid_t __user *egid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "old_gid_t __user *sgid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	172: syscallinfo.Syscall{
+		Num:     172,
+		Name:    "prctl",
+		Entry:   "sys_prctl",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int option",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long arg2",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long arg3",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long arg4",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long arg5",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	173: syscallinfo.Syscall{
+		Num:        173,
+		Name:       "rt_sigreturn",
+		Entry:      "sys_rt_sigreturn",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatSignal,
+	},
+	174: syscallinfo.Syscall{
+		Num:     174,
+		Name:    "rt_sigaction",
+		Entry:   "sys_rt_sigaction",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct sigaction __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct sigaction __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+	},
+	175: syscallinfo.Syscall{
+		Num:     175,
+		Name:    "rt_sigprocmask",
+		Entry:   "sys_rt_sigprocmask",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int how",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "sigset_t __user *set",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "sigset_t __user *oset",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t sigsetsize",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+	},
+	176: syscallinfo.Syscall{
+		Num:     176,
+		Name:    "rt_sigpending",
+		Entry:   "sys_rt_sigpending",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "sigset_t __user *set",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t sigsetsize",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+	},
+	177: syscallinfo.Syscall{
+		Num:     177,
+		Name:    "rt_sigtimedwait",
+		Entry:   "sys_rt_sigtimedwait",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const sigset_t __user *uthese",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "siginfo_t __user *uinfo",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct timespec __user *uts",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t sigsetsize",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+	},
+	178: syscallinfo.Syscall{
+		Num:     178,
+		Name:    "rt_sigqueueinfo",
+		Entry:   "sys_rt_sigqueueinfo",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int sig",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "siginfo_t __user *uinfo",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+	},
+	179: syscallinfo.Syscall{
+		Num:     179,
+		Name:    "rt_sigsuspend",
+		Entry:   "sys_rt_sigsuspend",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "sigset_t __user *unewset",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t sigsetsize",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+	},
+	180: syscallinfo.Syscall{
+		Num:     180,
+		Name:    "pread64",
+		Entry:   "sys_pread64",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "char __user *buf",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t count",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "loff_t pos",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	181: syscallinfo.Syscall{
+		Num:     181,
+		Name:    "pwrite64",
+		Entry:   "sys_pwrite64",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *buf",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t count",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "loff_t pos",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	182: syscallinfo.Syscall{
+		Num:     182,
+		Name:    "chown",
+		Entry:   "sys_chown16",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "old_uid_t user",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "old_gid_t group",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	183: syscallinfo.Syscall{
+		Num:     183,
+		Name:    "getcwd",
+		Entry:   "sys_getcwd",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "char __user *buf",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long size",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	184: syscallinfo.Syscall{
+		Num:     184,
+		Name:    "capget",
+		Entry:   "sys_capget",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "cap_user_header_t header",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "cap_user_data_t dataptr",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	185: syscallinfo.Syscall{
+		Num:     185,
+		Name:    "capset",
+		Entry:   "sys_capset",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "cap_user_header_t header",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "const cap_user_data_t data",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	186: syscallinfo.Syscall{
+		Num:     186,
+		Name:    "sigaltstack",
+		Entry:   "sys_sigaltstack",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const struct sigaltstack __user *uss",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct sigaltstack __user *uoss",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+	},
+	187: syscallinfo.Syscall{
+		Num:     187,
+		Name:    "sendfile",
+		Entry:   "sys_sendfile",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int out_fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int in_fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "off_t __user *offset",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t count",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	188: syscallinfo.Syscall{
+		Num:        188,
+		Name:       "getpmsg",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	189: syscallinfo.Syscall{
+		Num:        189,
+		Name:       "putpmsg",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	190: syscallinfo.Syscall{
+		Num:        190,
+		Name:       "vfork",
+		Entry:      "sys_vfork",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatProcess,
+	},
+	191: syscallinfo.Syscall{
+		Num:     191,
+		Name:    "ugetrlimit",
+		Entry:   "sys_getrlimit",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int resource",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct rlimit __user *rlim",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	192: syscallinfo.Syscall{
+		Num:     192,
+		Name:    "mmap2",
+		Entry:   "sys_mmap_pgoff",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long addr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long prot",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long pgoff",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatMemory,
+	},
+	193: syscallinfo.Syscall{
+		Num:     193,
+		Name:    "truncate64",
+		Entry:   "sys_truncate64",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "loff_t length",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	194: syscallinfo.Syscall{
+		Num:     194,
+		Name:    "ftruncate64",
+		Entry:   "sys_ftruncate64",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "loff_t length",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	195: syscallinfo.Syscall{
+		Num:     195,
+		Name:    "stat64",
+		Entry:   "sys_stat64",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct stat64 __user *statbuf",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatStat | syscallinfo.CatStatLike,
+	},
+	196: syscallinfo.Syscall{
+		Num:     196,
+		Name:    "lstat64",
+		Entry:   "sys_lstat64",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct stat64 __user *statbuf",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatLstat | syscallinfo.CatStatLike,
+	},
+	197: syscallinfo.Syscall{
+		Num:     197,
+		Name:    "fstat64",
+		Entry:   "sys_fstat64",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct stat64 __user *statbuf",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatFstat | syscallinfo.CatStatLike,
+	},
+	198: syscallinfo.Syscall{
+		Num:     198,
+		Name:    "lchown32",
+		Entry:   "sys_lchown",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "uid_t user",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "gid_t group",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	199: syscallinfo.Syscall{
+		Num:        199,
+		Name:       "getuid32",
+		Entry:      "sys_getuid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatCreds | syscallinfo.CatPure,
+	},
+	200: syscallinfo.Syscall{
+		Num:        200,
+		Name:       "getgid32",
+		Entry:      "sys_getgid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatCreds | syscallinfo.CatPure,
+	},
+	201: syscallinfo.Syscall{
+		Num:        201,
+		Name:       "geteuid32",
+		Entry:      "sys_geteuid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatCreds | syscallinfo.CatPure,
+	},
+	202: syscallinfo.Syscall{
+		Num:        202,
+		Name:       "getegid32",
+		Entry:      "sys_getegid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatCreds | syscallinfo.CatPure,
+	},
+	203: syscallinfo.Syscall{
+		Num:     203,
+		Name:    "setreuid32",
+		Entry:   "sys_setreuid",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "uid_t ruid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "uid_t euid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	204: syscallinfo.Syscall{
+		Num:     204,
+		Name:    "setregid32",
+		Entry:   "sys_setregid",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "gid_t rgid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "gid_t egid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	205: syscallinfo.Syscall{
+		Num:     205,
+		Name:    "getgroups32",
+		Entry:   "sys_getgroups",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int gidsetsize",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "gid_t __user *grouplist",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	206: syscallinfo.Syscall{
+		Num:     206,
+		Name:    "setgroups32",
+		Entry:   "sys_setgroups",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int gidsetsize",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "gid_t __user *grouplist",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	207: syscallinfo.Syscall{
+		Num:     207,
+		Name:    "fchown32",
+		Entry:   "sys_fchown",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "uid_t user",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "gid_t group",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	208: syscallinfo.Syscall{
+		Num:     208,
+		Name:    "setresuid32",
+		Entry:   "sys_setresuid",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "uid_t ruid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "uid_t euid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "uid_t suid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	209: syscallinfo.Syscall{
+		Num:     209,
+		Name:    "getresuid32",
+		Entry:   "sys_getresuid",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "uid_t __user *ruid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "uid_t __user *euid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "uid_t __user *suid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	210: syscallinfo.Syscall{
+		Num:     210,
+		Name:    "setresgid32",
+		Entry:   "sys_setresgid",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "gid_t rgid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "gid_t egid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "gid_t sgid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	211: syscallinfo.Syscall{
+		Num:     211,
+		Name:    "getresgid32",
+		Entry:   "sys_getresgid",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "gid_t __user *rgid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "gid_t __user *egid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "gid_t __user *sgid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	212: syscallinfo.Syscall{
+		Num:     212,
+		Name:    "chown32",
+		Entry:   "sys_chown",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "uid_t user",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "gid_t group",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	213: syscallinfo.Syscall{
+		Num:     213,
+		Name:    "setuid32",
+		Entry:   "sys_setuid",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "uid_t uid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	214: syscallinfo.Syscall{
+		Num:     214,
+		Name:    "setgid32",
+		Entry:   "sys_setgid",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "gid_t gid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	215: syscallinfo.Syscall{
+		Num:     215,
+		Name:    "setfsuid32",
+		Entry:   "sys_setfsuid",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "uid_t uid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	216: syscallinfo.Syscall{
+		Num:     216,
+		Name:    "setfsgid32",
+		Entry:   "sys_setfsgid",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "gid_t gid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatCreds,
+	},
+	217: syscallinfo.Syscall{
+		Num:     217,
+		Name:    "pivot_root",
+		Entry:   "sys_pivot_root",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *new_root",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *put_old",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	218: syscallinfo.Syscall{
+		Num:     218,
+		Name:    "mincore",
+		Entry:   "sys_mincore",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long start",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "unsigned char __user * vec",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+	},
+	219: syscallinfo.Syscall{
+		Num:     219,
+		Name:    "madvise",
+		Entry:   "sys_madvise",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long start",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int behavior",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+	},
+	220: syscallinfo.Syscall{
+		Num:     220,
+		Name:    "getdents64",
+		Entry:   "sys_getdents64",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct linux_dirent64 __user *dirent",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int count",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	221: syscallinfo.Syscall{
+		Num:     221,
+		Name:    "fcntl64",
+		Entry:   "sys_fcntl64",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxFD,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int cmd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long arg",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	224: syscallinfo.Syscall{
+		Num:        224,
+		Name:       "gettid",
+		Entry:      "sys_gettid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatPure,
+	},
+	225: syscallinfo.Syscall{
+		Num:     225,
+		Name:    "readahead",
+		Entry:   "sys_readahead",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "loff_t offset",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t count",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	226: syscallinfo.Syscall{
+		Num:     226,
+		Name:    "setxattr",
+		Entry:   "sys_setxattr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const void __user *value",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t size",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	227: syscallinfo.Syscall{
+		Num:     227,
+		Name:    "lsetxattr",
+		Entry:   "sys_lsetxattr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const void __user *value",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t size",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	228: syscallinfo.Syscall{
+		Num:     228,
+		Name:    "fsetxattr",
+		Entry:   "sys_fsetxattr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const void __user *value",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t size",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	229: syscallinfo.Syscall{
+		Num:     229,
+		Name:    "getxattr",
+		Entry:   "sys_getxattr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "void __user *value",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t size",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	230: syscallinfo.Syscall{
+		Num:     230,
+		Name:    "lgetxattr",
+		Entry:   "sys_lgetxattr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "void __user *value",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t size",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	231: syscallinfo.Syscall{
+		Num:     231,
+		Name:    "fgetxattr",
+		Entry:   "sys_fgetxattr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "void __user *value",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t size",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	232: syscallinfo.Syscall{
+		Num:     232,
+		Name:    "listxattr",
+		Entry:   "sys_listxattr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "char __user *list",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t size",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	233: syscallinfo.Syscall{
+		Num:     233,
+		Name:    "llistxattr",
+		Entry:   "sys_llistxattr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "char __user *list",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t size",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	234: syscallinfo.Syscall{
+		Num:     234,
+		Name:    "flistxattr",
+		Entry:   "sys_flistxattr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "char __user *list",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t size",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	235: syscallinfo.Syscall{
+		Num:     235,
+		Name:    "removexattr",
+		Entry:   "sys_removexattr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	236: syscallinfo.Syscall{
+		Num:     236,
+		Name:    "lremovexattr",
+		Entry:   "sys_lremovexattr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	237: syscallinfo.Syscall{
+		Num:     237,
+		Name:    "fremovexattr",
+		Entry:   "sys_fremovexattr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	238: syscallinfo.Syscall{
+		Num:     238,
+		Name:    "tkill",
+		Entry:   "sys_tkill",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int sig",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+	},
+	239: syscallinfo.Syscall{
+		Num:     239,
+		Name:    "sendfile64",
+		Entry:   "sys_sendfile64",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int out_fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int in_fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "loff_t __user *offset",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t count",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	240: syscallinfo.Syscall{
+		Num:     240,
+		Name:    "futex",
+		Entry:   "sys_futex",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "u32 __user *uaddr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int op",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "u32 val",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct timespec __user *utime",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "u32 __user *uaddr2",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "u32 val3",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	241: syscallinfo.Syscall{
+		Num:     241,
+		Name:    "sched_setaffinity",
+		Entry:   "sys_sched_setaffinity",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "unsigned long __user *user_mask_ptr",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	242: syscallinfo.Syscall{
+		Num:     242,
+		Name:    "sched_getaffinity",
+		Entry:   "sys_sched_getaffinity",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "unsigned long __user *user_mask_ptr",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	243: syscallinfo.Syscall{
+		Num:     243,
+		Name:    "set_thread_area",
+		Entry:   "sys_set_thread_area",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct user_desc __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	244: syscallinfo.Syscall{
+		Num:     244,
+		Name:    "get_thread_area",
+		Entry:   "sys_get_thread_area",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct user_desc __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	245: syscallinfo.Syscall{
+		Num:     245,
+		Name:    "io_setup",
+		Entry:   "sys_io_setup",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned nr_reqs",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "aio_context_t __user *ctx",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+	},
+	246: syscallinfo.Syscall{
+		Num:     246,
+		Name:    "io_destroy",
+		Entry:   "sys_io_destroy",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "aio_context_t ctx",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+	},
+	247: syscallinfo.Syscall{
+		Num:     247,
+		Name:    "io_getevents",
+		Entry:   "sys_io_getevents",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "aio_context_t ctx_id",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "long min_nr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "long nr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct io_event __user *events",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct timespec __user *timeout",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	248: syscallinfo.Syscall{
+		Num:     248,
+		Name:    "io_submit",
+		Entry:   "sys_io_submit",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "aio_context_t",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "long",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 2,
+				Sig:      "struct iocb __user * __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	249: syscallinfo.Syscall{
+		Num:     249,
+		Name:    "io_cancel",
+		Entry:   "sys_io_cancel",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "aio_context_t ctx_id",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct iocb __user *iocb",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct io_event __user *result",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	250: syscallinfo.Syscall{
+		Num:     250,
+		Name:    "fadvise64",
+		Entry:   "sys_fadvise64",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "loff_t offset",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int advice",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	252: syscallinfo.Syscall{
+		Num:     252,
+		Name:    "exit_group",
+		Entry:   "sys_exit_group",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int error_code",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatProcess,
+	},
+	253: syscallinfo.Syscall{
+		Num:     253,
+		Name:    "lookup_dcookie",
+		Entry:   "sys_lookup_dcookie",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "u64 cookie64",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "char __user *buf",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t len",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Until:      syscallinfo.KernelVersion{Major: 6, Minor: 8, Patch: 0},
+	},
+	254: syscallinfo.Syscall{
+		Num:     254,
+		Name:    "epoll_create",
+		Entry:   "sys_epoll_create",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int size",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	255: syscallinfo.Syscall{
+		Num:     255,
+		Name:    "epoll_ctl",
+		Entry:   "sys_epoll_ctl",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int epfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int op",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct epoll_event __user *event",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	256: syscallinfo.Syscall{
+		Num:     256,
+		Name:    "epoll_wait",
+		Entry:   "sys_epoll_wait",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int epfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct epoll_event __user *events",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int maxevents",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int timeout",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	257: syscallinfo.Syscall{
+		Num:     257,
+		Name:    "remap_file_pages",
+		Entry:   "sys_remap_file_pages",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long start",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long size",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long prot",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long pgoff",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+	},
+	258: syscallinfo.Syscall{
+		Num:     258,
+		Name:    "set_tid_address",
+		Entry:   "sys_set_tid_address",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "int __user *tidptr",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	259: syscallinfo.Syscall{
+		Num:     259,
+		Name:    "timer_create",
+		Entry:   "sys_timer_create",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "clockid_t which_clock",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct sigevent __user *timer_event_spec",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "timer_t __user * created_timer_id",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	260: syscallinfo.Syscall{
+		Num:     260,
+		Name:    "timer_settime",
+		Entry:   "sys_timer_settime",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "timer_t timer_id",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct itimerspec __user *new_setting",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct itimerspec __user *old_setting",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	261: syscallinfo.Syscall{
+		Num:     261,
+		Name:    "timer_gettime",
+		Entry:   "sys_timer_gettime",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "timer_t timer_id",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct itimerspec __user *setting",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	262: syscallinfo.Syscall{
+		Num:     262,
+		Name:    "timer_getoverrun",
+		Entry:   "sys_timer_getoverrun",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "timer_t timer_id",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	263: syscallinfo.Syscall{
+		Num:     263,
+		Name:    "timer_delete",
+		Entry:   "sys_timer_delete",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "timer_t timer_id",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	264: syscallinfo.Syscall{
+		Num:     264,
+		Name:    "clock_settime",
+		Entry:   "sys_clock_settime",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "clockid_t which_clock",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct timespec __user *tp",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatClock,
+	},
+	265: syscallinfo.Syscall{
+		Num:     265,
+		Name:    "clock_gettime",
+		Entry:   "sys_clock_gettime",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "clockid_t which_clock",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct timespec __user *tp",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatClock,
+	},
+	266: syscallinfo.Syscall{
+		Num:     266,
+		Name:    "clock_getres",
+		Entry:   "sys_clock_getres",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "clockid_t which_clock",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct timespec __user *tp",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatClock,
+	},
+	267: syscallinfo.Syscall{
+		Num:     267,
+		Name:    "clock_nanosleep",
+		Entry:   "sys_clock_nanosleep",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "clockid_t which_clock",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct timespec __user *rqtp",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct timespec __user *rmtp",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	268: syscallinfo.Syscall{
+		Num:     268,
+		Name:    "statfs64",
+		Entry:   "sys_statfs64",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t sz",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct statfs64 __user *buf",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatStatfs | syscallinfo.CatStatfsLike,
+	},
+	269: syscallinfo.Syscall{
+		Num:     269,
+		Name:    "fstatfs64",
+		Entry:   "sys_fstatfs64",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t sz",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct statfs64 __user *buf",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatFstatfs | syscallinfo.CatStatfsLike,
+	},
+	270: syscallinfo.Syscall{
+		Num:     270,
+		Name:    "tgkill",
+		Entry:   "sys_tgkill",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int tgid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int sig",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+	},
+	271: syscallinfo.Syscall{
+		Num:     271,
+		Name:    "utimes",
+		Entry:   "sys_utimes",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct timeval __user *utimes",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	272: syscallinfo.Syscall{
+		Num:     272,
+		Name:    "fadvise64_64",
+		Entry:   "sys_fadvise64_64",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "loff_t offset",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "loff_t len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int advice",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+	},
+	273: syscallinfo.Syscall{
+		Num:        273,
+		Name:       "vserver",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	274: syscallinfo.Syscall{
+		Num:     274,
+		Name:    "mbind",
+		Entry:   "sys_mbind",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long start",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long mode",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const unsigned long __user *nmask",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long maxnode",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
+	},
+	275: syscallinfo.Syscall{
+		Num:     275,
+		Name:    "get_mempolicy",
+		Entry:   "sys_get_mempolicy",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "int __user *policy",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "unsigned long __user *nmask",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long maxnode",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long addr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
+	},
+	276: syscallinfo.Syscall{
+		Num:     276,
+		Name:    "set_mempolicy",
+		Entry:   "sys_set_mempolicy",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int mode",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const unsigned long __user *nmask",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long maxnode",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
+	},
+	277: syscallinfo.Syscall{
+		Num:     277,
+		Name:    "mq_open",
+		Entry:   "sys_mq_open",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int oflag",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "umode_t mode",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct mq_attr __user *attr",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
+	},
+	278: syscallinfo.Syscall{
+		Num:     278,
+		Name:    "mq_unlink",
+		Entry:   "sys_mq_unlink",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
+	},
+	279: syscallinfo.Syscall{
+		Num:     279,
+		Name:    "mq_timedsend",
+		Entry:   "sys_mq_timedsend",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "mqd_t mqdes",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *msg_ptr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t msg_len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int msg_prio",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct timespec __user *abs_timeout",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
+	},
+	280: syscallinfo.Syscall{
+		Num:     280,
+		Name:    "mq_timedreceive",
+		Entry:   "sys_mq_timedreceive",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "mqd_t mqdes",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "char __user *msg_ptr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t msg_len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "unsigned int __user *msg_prio",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct timespec __user *abs_timeout",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
+	},
+	281: syscallinfo.Syscall{
+		Num:     281,
+		Name:    "mq_notify",
+		Entry:   "sys_mq_notify",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "mqd_t mqdes",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct sigevent __user *notification",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
+	},
+	282: syscallinfo.Syscall{
+		Num:     282,
+		Name:    "mq_getsetattr",
+		Entry:   "sys_mq_getsetattr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "mqd_t mqdes",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct mq_attr __user *mqstat",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct mq_attr __user *omqstat",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
+	},
+	283: syscallinfo.Syscall{
+		Num:     283,
+		Name:    "kexec_load",
+		Entry:   "sys_kexec_load",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long entry",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long nr_segments",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct kexec_segment __user *segments",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 13},
+	},
+	284: syscallinfo.Syscall{
+		Num:     284,
+		Name:    "waitid",
+		Entry:   "sys_waitid",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int which",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct siginfo __user *infop",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int options",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct rusage __user *ru",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatProcess,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 9},
+	},
+	286: syscallinfo.Syscall{
+		Num:     286,
+		Name:    "add_key",
+		Entry:   "sys_add_key",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *_type",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *_description",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const void __user *_payload",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t plen",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "key_serial_t destringid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 10},
+	},
+	287: syscallinfo.Syscall{
+		Num:     287,
+		Name:    "request_key",
+		Entry:   "sys_request_key",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *_type",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *_description",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *_callout_info",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "key_serial_t destringid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 10},
+	},
+	288: syscallinfo.Syscall{
+		Num:     288,
+		Name:    "keyctl",
+		Entry:   "sys_keyctl",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int cmd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long arg2",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long arg3",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long arg4",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long arg5",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 10},
+	},
+	289: syscallinfo.Syscall{
+		Num:     289,
+		Name:    "ioprio_set",
+		Entry:   "sys_ioprio_set",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int which",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int who",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int ioprio",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 13},
+	},
+	290: syscallinfo.Syscall{
+		Num:     290,
+		Name:    "ioprio_get",
+		Entry:   "sys_ioprio_get",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int which",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int who",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 13},
+	},
+	291: syscallinfo.Syscall{
+		Num:        291,
+		Name:       "inotify_init",
+		Entry:      "sys_inotify_init",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 13},
+	},
+	292: syscallinfo.Syscall{
+		Num:     292,
+		Name:    "inotify_add_watch",
+		Entry:   "sys_inotify_add_watch",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "u32 mask",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 13},
+	},
+	293: syscallinfo.Syscall{
+		Num:     293,
+		Name:    "inotify_rm_watch",
+		Entry:   "sys_inotify_rm_watch",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "__s32 wd",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 13},
+	},
+	294: syscallinfo.Syscall{
+		Num:     294,
+		Name:    "migrate_pages",
+		Entry:   "sys_migrate_pages",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long maxnode",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const unsigned long __user *from",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const unsigned long __user *to",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
+	},
+	295: syscallinfo.Syscall{
+		Num:     295,
+		Name:    "openat",
+		Entry:   "sys_openat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "umode_t mode",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
+	},
+	296: syscallinfo.Syscall{
+		Num:     296,
+		Name:    "mkdirat",
+		Entry:   "sys_mkdirat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user * pathname",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "umode_t mode",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
+	},
+	297: syscallinfo.Syscall{
+		Num:     297,
+		Name:    "mknodat",
+		Entry:   "sys_mknodat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user * filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "umode_t mode",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned dev",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
+	},
+	298: syscallinfo.Syscall{
+		Num:     298,
+		Name:    "fchownat",
+		Entry:   "sys_fchownat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "uid_t user",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "gid_t group",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flag",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
+	},
+	299: syscallinfo.Syscall{
+		Num:     299,
+		Name:    "futimesat",
+		Entry:   "sys_futimesat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct timeval __user *utimes",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
+	},
+	300: syscallinfo.Syscall{
+		Num:     300,
+		Name:    "fstatat64",
+		Entry:   "sys_fstatat64",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct stat64 __user *statbuf",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flag",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc | syscallinfo.CatFstat | syscallinfo.CatStatLike,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
+	},
+	301: syscallinfo.Syscall{
+		Num:     301,
+		Name:    "unlinkat",
+		Entry:   "sys_unlinkat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user * pathname",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flag",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
+	},
+	302: syscallinfo.Syscall{
+		Num:     302,
+		Name:    "renameat",
+		Entry:   "sys_renameat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int olddfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user * oldname",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int newdfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user * newname",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
+	},
+	303: syscallinfo.Syscall{
+		Num:     303,
+		Name:    "linkat",
+		Entry:   "sys_linkat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int olddfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *oldname",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int newdfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *newname",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
+	},
+	304: syscallinfo.Syscall{
+		Num:     304,
+		Name:    "symlinkat",
+		Entry:   "sys_symlinkat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user * oldname",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int newdfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user * newname",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
+	},
+	305: syscallinfo.Syscall{
+		Num:     305,
+		Name:    "readlinkat",
+		Entry:   "sys_readlinkat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "char __user *buf",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int bufsiz",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
+	},
+	306: syscallinfo.Syscall{
+		Num:     306,
+		Name:    "fchmodat",
+		Entry:   "sys_fchmodat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user * filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "umode_t mode",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
+	},
+	307: syscallinfo.Syscall{
+		Num:     307,
+		Name:    "faccessat",
+		Entry:   "sys_faccessat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int mode",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
+	},
+	308: syscallinfo.Syscall{
+		Num:     308,
+		Name:    "pselect6",
+		Entry:   "sys_pselect6",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int n",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "fd_set __user *inp",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "fd_set __user *outp",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "fd_set __user *exp",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct timespec __user *tsp",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "void __user *sig",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
+	},
+	309: syscallinfo.Syscall{
+		Num:     309,
+		Name:    "ppoll",
+		Entry:   "sys_ppoll",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct pollfd __user *ufds",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int nfds",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct timespec __user *tsp",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const sigset_t __user *sigmask",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t sigsetsize",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
+	},
+	310: syscallinfo.Syscall{
+		Num:     310,
+		Name:    "unshare",
+		Entry:   "sys_unshare",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long unshare_flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatProcess,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
+	},
+	311: syscallinfo.Syscall{
+		Num:     311,
+		Name:    "set_robust_list",
+		Entry:   "sys_set_robust_list",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct robust_list_head __user *head",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t len",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 17},
+	},
+	312: syscallinfo.Syscall{
+		Num:     312,
+		Name:    "get_robust_list",
+		Entry:   "sys_get_robust_list",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 2,
+				Sig:      "struct robust_list_head __user * __user *head_ptr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "size_t __user *len_ptr",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 17},
+	},
+	313: syscallinfo.Syscall{
+		Num:     313,
+		Name:    "splice",
+		Entry:   "sys_splice",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd_in",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "loff_t __user *off_in",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int fd_out",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "loff_t __user *off_out",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 17},
+	},
+	314: syscallinfo.Syscall{
+		Num:     314,
+		Name:    "sync_file_range",
+		Entry:   "sys_sync_file_range",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "loff_t offset",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "loff_t nbytes",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 17},
+	},
+	315: syscallinfo.Syscall{
+		Num:     315,
+		Name:    "tee",
+		Entry:   "sys_tee",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fdin",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int fdout",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 17},
+	},
+	316: syscallinfo.Syscall{
+		Num:     316,
+		Name:    "vmsplice",
+		Entry:   "sys_vmsplice",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct iovec __user *iov",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long nr_segs",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 17},
+	},
+	317: syscallinfo.Syscall{
+		Num:     317,
+		Name:    "move_pages",
+		Entry:   "sys_move_pages",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long nr_pages",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 2,
+				Sig:      "const void __user * __user *pages",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const int __user *nodes",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "int __user *status",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 18},
+	},
+	318: syscallinfo.Syscall{
+		Num:     318,
+		Name:    "getcpu",
+		Entry:   "sys_getcpu",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "unsigned __user *cpu",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "unsigned __user *node",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct getcpu_cache __user *cache",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 19},
+	},
+	319: syscallinfo.Syscall{
+		Num:     319,
+		Name:    "epoll_pwait",
+		Entry:   "sys_epoll_pwait",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int epfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct epoll_event __user *events",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int maxevents",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int timeout",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const sigset_t __user *sigmask",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t sigsetsize",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 19},
+	},
+	320: syscallinfo.Syscall{
+		Num:     320,
+		Name:    "utimensat",
+		Entry:   "sys_utimensat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct timespec __user *utimes",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 22},
+	},
+	321: syscallinfo.Syscall{
+		Num:     321,
+		Name:    "signalfd",
+		Entry:   "sys_signalfd",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int ufd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "sigset_t __user *user_mask",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t sizemask",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatSignal,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 22},
+	},
+	322: syscallinfo.Syscall{
+		Num:     322,
+		Name:    "timerfd_create",
+		Entry:   "sys_timerfd_create",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int clockid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 25},
+	},
+	323: syscallinfo.Syscall{
+		Num:     323,
+		Name:    "eventfd",
+		Entry:   "sys_eventfd",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int count",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 22},
+	},
+	324: syscallinfo.Syscall{
+		Num:     324,
+		Name:    "fallocate",
+		Entry:   "sys_fallocate",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int mode",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "loff_t offset",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "loff_t len",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 23},
+	},
+	325: syscallinfo.Syscall{
+		Num:     325,
+		Name:    "timerfd_settime",
+		Entry:   "sys_timerfd_settime",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int ufd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct itimerspec __user *utmr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct itimerspec __user *otmr",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 25},
+	},
+	326: syscallinfo.Syscall{
+		Num:     326,
+		Name:    "timerfd_gettime",
+		Entry:   "sys_timerfd_gettime",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int ufd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct itimerspec __user *otmr",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 25},
+	},
+	327: syscallinfo.Syscall{
+		Num:     327,
+		Name:    "signalfd4",
+		Entry:   "sys_signalfd4",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int ufd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "sigset_t __user *user_mask",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t sizemask",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatSignal,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 27},
+	},
+	328: syscallinfo.Syscall{
+		Num:     328,
+		Name:    "eventfd2",
+		Entry:   "sys_eventfd2",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int count",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 27},
+	},
+	329: syscallinfo.Syscall{
+		Num:     329,
+		Name:    "epoll_create1",
+		Entry:   "sys_epoll_create1",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 27},
+	},
+	330: syscallinfo.Syscall{
+		Num:     330,
+		Name:    "dup3",
+		Entry:   "sys_dup3",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int oldfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int newfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 27},
+	},
+	331: syscallinfo.Syscall{
+		Num:     331,
+		Name:    "pipe2",
+		Entry:   "sys_pipe2",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "int __user *fildes",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 27},
+	},
+	332: syscallinfo.Syscall{
+		Num:     332,
+		Name:    "inotify_init1",
+		Entry:   "sys_inotify_init1",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 27},
+	},
+	333: syscallinfo.Syscall{
+		Num:     333,
+		Name:    "preadv",
+		Entry:   "sys_preadv",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct iovec __user *vec",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long vlen",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long pos_l",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long pos_h",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 30},
+	},
+	334: syscallinfo.Syscall{
+		Num:     334,
+		Name:    "pwritev",
+		Entry:   "sys_pwritev",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct iovec __user *vec",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long vlen",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long pos_l",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long pos_h",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 30},
+	},
+	335: syscallinfo.Syscall{
+		Num:     335,
+		Name:    "rt_tgsigqueueinfo",
+		Entry:   "sys_rt_tgsigqueueinfo",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t tgid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int sig",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "siginfo_t __user *uinfo",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 31},
+	},
+	336: syscallinfo.Syscall{
+		Num:     336,
+		Name:    "perf_event_open",
+		Entry:   "sys_perf_event_open",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct perf_event_attr __user *attr_uptr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int cpu",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int group_fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 31},
+	},
+	337: syscallinfo.Syscall{
+		Num:     337,
+		Name:    "recvmmsg",
+		Entry:   "sys_recvmmsg",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct mmsghdr __user *msg",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int vlen",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct timespec __user *timeout",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 33},
+	},
+	338: syscallinfo.Syscall{
+		Num:     338,
+		Name:    "fanotify_init",
+		Entry:   "sys_fanotify_init",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int event_f_flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 37},
+	},
+	339: syscallinfo.Syscall{
+		Num:     339,
+		Name:    "fanotify_mark",
+		Entry:   "sys_fanotify_mark",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fanotify_fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "u64 mask",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *pathname",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 37},
+	},
+	340: syscallinfo.Syscall{
+		Num:     340,
+		Name:    "prlimit64",
+		Entry:   "sys_prlimit64",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int resource",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct rlimit64 __user *new_rlim",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct rlimit64 __user *old_rlim",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 36},
+	},
+	341: syscallinfo.Syscall{
+		Num:     341,
+		Name:    "name_to_handle_at",
+		Entry:   "sys_name_to_handle_at",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct file_handle __user *handle",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "int __user *mnt_id",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flag",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 39},
+	},
+	342: syscallinfo.Syscall{
+		Num:     342,
+		Name:    "open_by_handle_at",
+		Entry:   "sys_open_by_handle_at",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int mountdirfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct file_handle __user *handle",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 39},
+	},
+	343: syscallinfo.Syscall{
+		Num:     343,
+		Name:    "clock_adjtime",
+		Entry:   "sys_clock_adjtime",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "clockid_t which_clock",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct timex __user *tx",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatClock,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 39},
+	},
+	344: syscallinfo.Syscall{
+		Num:     344,
+		Name:    "syncfs",
+		Entry:   "sys_syncfs",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 39},
+	},
+	345: syscallinfo.Syscall{
+		Num:     345,
+		Name:    "sendmmsg",
+		Entry:   "sys_sendmmsg",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct mmsghdr __user *msg",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int vlen",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 0, Patch: 0},
+	},
+	346: syscallinfo.Syscall{
+		Num:     346,
+		Name:    "setns",
+		Entry:   "sys_setns",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int nstype",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 0, Patch: 0},
+	},
+	347: syscallinfo.Syscall{
+		Num:     347,
+		Name:    "process_vm_readv",
+		Entry:   "sys_process_vm_readv",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct iovec __user *lvec",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long liovcnt",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct iovec __user *rvec",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long riovcnt",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 2, Patch: 0},
+	},
+	348: syscallinfo.Syscall{
+		Num:     348,
+		Name:    "process_vm_writev",
+		Entry:   "sys_process_vm_writev",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct iovec __user *lvec",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long liovcnt",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct iovec __user *rvec",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long riovcnt",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 2, Patch: 0},
+	},
+	349: syscallinfo.Syscall{
+		Num:     349,
+		Name:    "kcmp",
+		Entry:   "sys_kcmp",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid1",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid2",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int type",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long idx1",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long idx2",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 5, Patch: 0},
+	},
+	350: syscallinfo.Syscall{
+		Num:     350,
+		Name:    "finit_module",
+		Entry:   "sys_finit_module",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *uargs",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 8, Patch: 0},
+	},
+	351: syscallinfo.Syscall{
+		Num:     351,
+		Name:    "sched_setattr",
+		Entry:   "sys_sched_setattr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct sched_attr __user *attr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 14, Patch: 0},
+	},
+	352: syscallinfo.Syscall{
+		Num:     352,
+		Name:    "sched_getattr",
+		Entry:   "sys_sched_getattr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct sched_attr __user *attr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int size",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 14, Patch: 0},
+	},
+	353: syscallinfo.Syscall{
+		Num:     353,
+		Name:    "renameat2",
+		Entry:   "sys_renameat2",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int olddfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *oldname",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int newdfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *newname",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 15, Patch: 0},
+	},
+	354: syscallinfo.Syscall{
+		Num:     354,
+		Name:    "seccomp",
+		Entry:   "sys_seccomp",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int op",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *uargs",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 17, Patch: 0},
+	},
+	355: syscallinfo.Syscall{
+		Num:     355,
+		Name:    "getrandom",
+		Entry:   "sys_getrandom",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "char __user *buf",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t count",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 17, Patch: 0},
+	},
+	356: syscallinfo.Syscall{
+		Num:     356,
+		Name:    "memfd_create",
+		Entry:   "sys_memfd_create",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *uname_ptr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 17, Patch: 0},
+	},
+	357: syscallinfo.Syscall{
+		Num:     357,
+		Name:    "bpf",
+		Entry:   "sys_bpf",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int cmd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "union bpf_attr *attr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int size",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 18, Patch: 0},
+	},
+	358: syscallinfo.Syscall{
+		Num:     358,
+		Name:    "execveat",
+		Entry:   "sys_execveat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 2,
+				Sig:      "const char __user *const __user *argv",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 2,
+				Sig:      "const char __user *const __user *envp",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc | syscallinfo.CatProcess,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 19, Patch: 0},
+	},
+}
@@ -4,4 +4,7 @@
 
 package linux_amd64
 
-//go:generate go run $GOPATH/src/github.com/jroimartin/syscallinfo/mksyscalltable.go -output syscalltable.go linux_amd64 syscall_64.json
+//go:generate go run $GOPATH/src/github.com/jroimartin/syscallinfo/mksyscalltable.go -output syscalltable.go -categories ../categories.json -versions ../versions.json linux_amd64 syscall_64.json
+//go:generate go run $GOPATH/src/github.com/jroimartin/syscallinfo/mksyscalltable.go -output syscalltable_4_0.go -var SyscallTable4_0 -categories ../categories.json -versions ../versions.json linux_amd64 syscall_64_4.0.json
+//go:generate go run $GOPATH/src/github.com/jroimartin/syscallinfo/mksyscalltable.go -embed -output syscalltable_embed.go -categories ../categories.json -versions ../versions.json linux_amd64 syscall_64.json
+//go:generate go run $GOPATH/src/github.com/jroimartin/syscallinfo/mksyscalltable.go -embed -output syscalltable_4_0_embed.go -var SyscallTable4_0 -categories ../categories.json -versions ../versions.json linux_amd64 syscall_64_4.0.json
@@ -0,0 +1,13 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linux_amd64
+
+import "github.com/jroimartin/syscallinfo"
+
+func init() {
+	syscallinfo.Register("linux_amd64", SyscallTable)
+	syscallinfo.RegisterSnapshot("linux_amd64", "4.0", SyscallTable4_0)
+	syscallinfo.RegisterSnapshot("linux_amd64", "6.15", SyscallTable)
+}
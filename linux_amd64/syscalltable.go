@@ -1,5 +1,8 @@
 // MACHINE GENERATED BY 'go generate' COMMAND; DO NOT EDIT
 
+//go:build !syscallinfo_embed
+// +build !syscallinfo_embed
+
 package linux_amd64
 
 import "github.com/jroimartin/syscallinfo"
@@ -9,7458 +12,9824 @@ var SyscallTable = syscallinfo.SyscallTable{
 		Num:     0,
 		Name:    "read",
 		Entry:   "sys_read",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	1: syscallinfo.Syscall{
 		Num:     1,
 		Name:    "write",
 		Entry:   "sys_write",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	2: syscallinfo.Syscall{
 		Num:     2,
 		Name:    "open",
 		Entry:   "sys_open",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "umode_t mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
 	},
 	3: syscallinfo.Syscall{
 		Num:     3,
 		Name:    "close",
 		Entry:   "sys_close",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	4: syscallinfo.Syscall{
 		Num:     4,
 		Name:    "stat",
 		Entry:   "sys_newstat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct stat __user *statbuf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatStat | syscallinfo.CatStatLike,
 	},
 	5: syscallinfo.Syscall{
 		Num:     5,
 		Name:    "fstat",
 		Entry:   "sys_newfstat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct stat __user *statbuf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatFstat | syscallinfo.CatStatLike,
 	},
 	6: syscallinfo.Syscall{
 		Num:     6,
 		Name:    "lstat",
 		Entry:   "sys_newlstat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct stat __user *statbuf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatLstat | syscallinfo.CatStatLike,
 	},
 	7: syscallinfo.Syscall{
 		Num:     7,
 		Name:    "poll",
 		Entry:   "sys_poll",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct pollfd __user *ufds",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int nfds",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int timeout",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	8: syscallinfo.Syscall{
 		Num:     8,
 		Name:    "lseek",
 		Entry:   "sys_lseek",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "off_t offset",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int whence",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	9: syscallinfo.Syscall{
 		Num:     9,
 		Name:    "mmap",
 		Entry:   "sys_mmap",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long addr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int prot",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "long off",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatMemory,
 	},
 	10: syscallinfo.Syscall{
 		Num:     10,
 		Name:    "mprotect",
 		Entry:   "sys_mprotect",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long start",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long prot",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	11: syscallinfo.Syscall{
 		Num:     11,
 		Name:    "munmap",
 		Entry:   "sys_munmap",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long addr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	12: syscallinfo.Syscall{
 		Num:     12,
 		Name:    "brk",
 		Entry:   "sys_brk",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long brk",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	13: syscallinfo.Syscall{
 		Num:     13,
 		Name:    "rt_sigaction",
 		Entry:   "sys_rt_sigaction",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct sigaction __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sigaction __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	14: syscallinfo.Syscall{
 		Num:     14,
 		Name:    "rt_sigprocmask",
 		Entry:   "sys_rt_sigprocmask",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int how",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "sigset_t __user *set",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "sigset_t __user *oset",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t sigsetsize",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	15: syscallinfo.Syscall{
-		Num:     15,
-		Name:    "rt_sigreturn",
-		Entry:   "sys_rt_sigreturn",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        15,
+		Name:       "rt_sigreturn",
+		Entry:      "sys_rt_sigreturn",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatSignal,
 	},
 	16: syscallinfo.Syscall{
 		Num:     16,
 		Name:    "ioctl",
 		Entry:   "sys_ioctl",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int cmd",
-				Context:  0,
+				Context:  syscallinfo.CtxIoctlReq,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	17: syscallinfo.Syscall{
 		Num:     17,
 		Name:    "pread64",
 		Entry:   "sys_pread64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "loff_t pos",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	18: syscallinfo.Syscall{
 		Num:     18,
 		Name:    "pwrite64",
 		Entry:   "sys_pwrite64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "loff_t pos",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	19: syscallinfo.Syscall{
 		Num:     19,
 		Name:    "readv",
 		Entry:   "sys_readv",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct iovec __user *vec",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long vlen",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	20: syscallinfo.Syscall{
 		Num:     20,
 		Name:    "writev",
 		Entry:   "sys_writev",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct iovec __user *vec",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long vlen",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	21: syscallinfo.Syscall{
 		Num:     21,
 		Name:    "access",
 		Entry:   "sys_access",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	22: syscallinfo.Syscall{
 		Num:     22,
 		Name:    "pipe",
 		Entry:   "sys_pipe",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "int __user *fildes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	23: syscallinfo.Syscall{
 		Num:     23,
 		Name:    "select",
 		Entry:   "sys_select",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int n",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "fd_set __user *inp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "fd_set __user *outp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "fd_set __user *exp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timeval __user *tvp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	24: syscallinfo.Syscall{
-		Num:     24,
-		Name:    "sched_yield",
-		Entry:   "sys_sched_yield",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        24,
+		Name:       "sched_yield",
+		Entry:      "sys_sched_yield",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
 	},
 	25: syscallinfo.Syscall{
 		Num:     25,
 		Name:    "mremap",
 		Entry:   "sys_mremap",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long addr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long old_len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long new_len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long new_addr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	26: syscallinfo.Syscall{
 		Num:     26,
 		Name:    "msync",
 		Entry:   "sys_msync",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long start",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	27: syscallinfo.Syscall{
 		Num:     27,
 		Name:    "mincore",
 		Entry:   "sys_mincore",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long start",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "unsigned char __user * vec",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	28: syscallinfo.Syscall{
 		Num:     28,
 		Name:    "madvise",
 		Entry:   "sys_madvise",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long start",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int behavior",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	29: syscallinfo.Syscall{
 		Num:     29,
 		Name:    "shmget",
 		Entry:   "sys_shmget",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "key_t key",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flag",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatIPC,
 	},
 	30: syscallinfo.Syscall{
 		Num:     30,
 		Name:    "shmat",
 		Entry:   "sys_shmat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int shmid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *shmaddr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int shmflg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatIPC | syscallinfo.CatMemory,
 	},
 	31: syscallinfo.Syscall{
 		Num:     31,
 		Name:    "shmctl",
 		Entry:   "sys_shmctl",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int shmid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int cmd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct shmid_ds __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatIPC,
 	},
 	32: syscallinfo.Syscall{
 		Num:     32,
 		Name:    "dup",
 		Entry:   "sys_dup",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fildes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	33: syscallinfo.Syscall{
 		Num:     33,
 		Name:    "dup2",
 		Entry:   "sys_dup2",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int oldfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int newfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	34: syscallinfo.Syscall{
-		Num:     34,
-		Name:    "pause",
-		Entry:   "sys_pause",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        34,
+		Name:       "pause",
+		Entry:      "sys_pause",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatSignal,
 	},
 	35: syscallinfo.Syscall{
 		Num:     35,
 		Name:    "nanosleep",
 		Entry:   "sys_nanosleep",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *rqtp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *rmtp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	36: syscallinfo.Syscall{
 		Num:     36,
 		Name:    "getitimer",
 		Entry:   "sys_getitimer",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int which",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct itimerval __user *value",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	37: syscallinfo.Syscall{
 		Num:     37,
 		Name:    "alarm",
 		Entry:   "sys_alarm",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int seconds",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	38: syscallinfo.Syscall{
 		Num:     38,
 		Name:    "setitimer",
 		Entry:   "sys_setitimer",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int which",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct itimerval __user *value",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct itimerval __user *ovalue",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	39: syscallinfo.Syscall{
-		Num:     39,
-		Name:    "getpid",
-		Entry:   "sys_getpid",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        39,
+		Name:       "getpid",
+		Entry:      "sys_getpid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatPure,
 	},
 	40: syscallinfo.Syscall{
 		Num:     40,
 		Name:    "sendfile",
 		Entry:   "sys_sendfile64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int out_fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int in_fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "loff_t __user *offset",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	41: syscallinfo.Syscall{
 		Num:     41,
 		Name:    "socket",
 		Entry:   "sys_socket",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
 	},
 	42: syscallinfo.Syscall{
 		Num:     42,
 		Name:    "connect",
 		Entry:   "sys_connect",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sockaddr __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
 	},
 	43: syscallinfo.Syscall{
 		Num:     43,
 		Name:    "accept",
 		Entry:   "sys_accept",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sockaddr __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "int __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
 	},
 	44: syscallinfo.Syscall{
 		Num:     44,
 		Name:    "sendto",
 		Entry:   "sys_sendto",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "void __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sockaddr __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
 	},
 	45: syscallinfo.Syscall{
 		Num:     45,
 		Name:    "recvfrom",
 		Entry:   "sys_recvfrom",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "void __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sockaddr __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "int __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
 	},
 	46: syscallinfo.Syscall{
 		Num:     46,
 		Name:    "sendmsg",
 		Entry:   "sys_sendmsg",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct user_msghdr __user *msg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
 	},
 	47: syscallinfo.Syscall{
 		Num:     47,
 		Name:    "recvmsg",
 		Entry:   "sys_recvmsg",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct user_msghdr __user *msg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
 	},
 	48: syscallinfo.Syscall{
 		Num:     48,
 		Name:    "shutdown",
 		Entry:   "sys_shutdown",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
 	},
 	49: syscallinfo.Syscall{
 		Num:     49,
 		Name:    "bind",
 		Entry:   "sys_bind",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sockaddr __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
 	},
 	50: syscallinfo.Syscall{
 		Num:     50,
 		Name:    "listen",
 		Entry:   "sys_listen",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
 	},
 	51: syscallinfo.Syscall{
 		Num:     51,
 		Name:    "getsockname",
 		Entry:   "sys_getsockname",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sockaddr __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "int __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
 	},
 	52: syscallinfo.Syscall{
 		Num:     52,
 		Name:    "getpeername",
 		Entry:   "sys_getpeername",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sockaddr __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "int __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
 	},
 	53: syscallinfo.Syscall{
 		Num:     53,
 		Name:    "socketpair",
 		Entry:   "sys_socketpair",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "int __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
 	},
 	54: syscallinfo.Syscall{
 		Num:     54,
 		Name:    "setsockopt",
 		Entry:   "sys_setsockopt",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int level",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int optname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *optval",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int optlen",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
 	},
 	55: syscallinfo.Syscall{
 		Num:     55,
 		Name:    "getsockopt",
 		Entry:   "sys_getsockopt",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int level",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int optname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *optval",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "int __user *optlen",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
 	},
 	56: syscallinfo.Syscall{
 		Num:     56,
 		Name:    "clone",
 		Entry:   "sys_clone",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "int __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "int __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatProcess,
 	},
 	57: syscallinfo.Syscall{
-		Num:     57,
-		Name:    "fork",
-		Entry:   "sys_fork",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        57,
+		Name:       "fork",
+		Entry:      "sys_fork",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatProcess,
 	},
 	58: syscallinfo.Syscall{
-		Num:     58,
-		Name:    "vfork",
-		Entry:   "sys_vfork",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        58,
+		Name:       "vfork",
+		Entry:      "sys_vfork",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatProcess,
 	},
 	59: syscallinfo.Syscall{
 		Num:     59,
 		Name:    "execve",
 		Entry:   "sys_execve",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 2,
 				Sig:      "const char __user *const __user *argv",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 2,
 				Sig:      "const char __user *const __user *envp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatProcess,
 	},
 	60: syscallinfo.Syscall{
 		Num:     60,
 		Name:    "exit",
 		Entry:   "sys_exit",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int error_code",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatProcess,
 	},
 	61: syscallinfo.Syscall{
 		Num:     61,
 		Name:    "wait4",
 		Entry:   "sys_wait4",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "int __user *stat_addr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int options",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct rusage __user *ru",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatProcess,
 	},
 	62: syscallinfo.Syscall{
 		Num:     62,
 		Name:    "kill",
 		Entry:   "sys_kill",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int sig",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	63: syscallinfo.Syscall{
 		Num:     63,
 		Name:    "uname",
 		Entry:   "sys_newuname",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct new_utsname __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	64: syscallinfo.Syscall{
 		Num:     64,
 		Name:    "semget",
 		Entry:   "sys_semget",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "key_t key",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int nsems",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int semflg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatIPC,
 	},
 	65: syscallinfo.Syscall{
 		Num:     65,
 		Name:    "semop",
 		Entry:   "sys_semop",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int semid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sembuf __user *sops",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned nsops",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatIPC,
 	},
 	66: syscallinfo.Syscall{
 		Num:     66,
 		Name:    "semctl",
 		Entry:   "sys_semctl",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int semid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int semnum",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int cmd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatIPC,
 	},
 	67: syscallinfo.Syscall{
 		Num:     67,
 		Name:    "shmdt",
 		Entry:   "sys_shmdt",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "char __user *shmaddr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatIPC | syscallinfo.CatMemory,
 	},
 	68: syscallinfo.Syscall{
 		Num:     68,
 		Name:    "msgget",
 		Entry:   "sys_msgget",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "key_t key",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int msgflg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatIPC,
 	},
 	69: syscallinfo.Syscall{
 		Num:     69,
 		Name:    "msgsnd",
 		Entry:   "sys_msgsnd",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int msqid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct msgbuf __user *msgp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t msgsz",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int msgflg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatIPC,
 	},
 	70: syscallinfo.Syscall{
 		Num:     70,
 		Name:    "msgrcv",
 		Entry:   "sys_msgrcv",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int msqid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct msgbuf __user *msgp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t msgsz",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "long msgtyp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int msgflg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatIPC,
 	},
 	71: syscallinfo.Syscall{
 		Num:     71,
 		Name:    "msgctl",
 		Entry:   "sys_msgctl",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int msqid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int cmd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct msqid_ds __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatIPC,
 	},
 	72: syscallinfo.Syscall{
 		Num:     72,
 		Name:    "fcntl",
 		Entry:   "sys_fcntl",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxFD,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int cmd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	73: syscallinfo.Syscall{
 		Num:     73,
 		Name:    "flock",
 		Entry:   "sys_flock",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int cmd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	74: syscallinfo.Syscall{
 		Num:     74,
 		Name:    "fsync",
 		Entry:   "sys_fsync",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	75: syscallinfo.Syscall{
 		Num:     75,
 		Name:    "fdatasync",
 		Entry:   "sys_fdatasync",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	76: syscallinfo.Syscall{
 		Num:     76,
 		Name:    "truncate",
 		Entry:   "sys_truncate",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "long length",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	77: syscallinfo.Syscall{
 		Num:     77,
 		Name:    "ftruncate",
 		Entry:   "sys_ftruncate",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long length",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	78: syscallinfo.Syscall{
 		Num:     78,
 		Name:    "getdents",
 		Entry:   "sys_getdents",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct linux_dirent __user *dirent",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	79: syscallinfo.Syscall{
 		Num:     79,
 		Name:    "getcwd",
 		Entry:   "sys_getcwd",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "char __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	80: syscallinfo.Syscall{
 		Num:     80,
 		Name:    "chdir",
 		Entry:   "sys_chdir",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	81: syscallinfo.Syscall{
 		Num:     81,
 		Name:    "fchdir",
 		Entry:   "sys_fchdir",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	82: syscallinfo.Syscall{
 		Num:     82,
 		Name:    "rename",
 		Entry:   "sys_rename",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *oldname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *newname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	83: syscallinfo.Syscall{
 		Num:     83,
 		Name:    "mkdir",
 		Entry:   "sys_mkdir",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *pathname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "umode_t mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	84: syscallinfo.Syscall{
 		Num:     84,
 		Name:    "rmdir",
 		Entry:   "sys_rmdir",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *pathname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	85: syscallinfo.Syscall{
 		Num:     85,
 		Name:    "creat",
 		Entry:   "sys_creat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *pathname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "umode_t mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
 	},
 	86: syscallinfo.Syscall{
 		Num:     86,
 		Name:    "link",
 		Entry:   "sys_link",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *oldname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *newname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	87: syscallinfo.Syscall{
 		Num:     87,
 		Name:    "unlink",
 		Entry:   "sys_unlink",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *pathname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	88: syscallinfo.Syscall{
 		Num:     88,
 		Name:    "symlink",
 		Entry:   "sys_symlink",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *old",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *new",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	89: syscallinfo.Syscall{
 		Num:     89,
 		Name:    "readlink",
 		Entry:   "sys_readlink",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int bufsiz",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	90: syscallinfo.Syscall{
 		Num:     90,
 		Name:    "chmod",
 		Entry:   "sys_chmod",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "umode_t mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	91: syscallinfo.Syscall{
 		Num:     91,
 		Name:    "fchmod",
 		Entry:   "sys_fchmod",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "umode_t mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	92: syscallinfo.Syscall{
 		Num:     92,
 		Name:    "chown",
 		Entry:   "sys_chown",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "uid_t user",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "gid_t group",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	93: syscallinfo.Syscall{
 		Num:     93,
 		Name:    "fchown",
 		Entry:   "sys_fchown",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "uid_t user",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "gid_t group",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	94: syscallinfo.Syscall{
 		Num:     94,
 		Name:    "lchown",
 		Entry:   "sys_lchown",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "uid_t user",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "gid_t group",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	95: syscallinfo.Syscall{
 		Num:     95,
 		Name:    "umask",
 		Entry:   "sys_umask",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int mask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	96: syscallinfo.Syscall{
 		Num:     96,
 		Name:    "gettimeofday",
 		Entry:   "sys_gettimeofday",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct timeval __user *tv",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timezone __user *tz",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatClock,
 	},
 	97: syscallinfo.Syscall{
 		Num:     97,
 		Name:    "getrlimit",
 		Entry:   "sys_getrlimit",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int resource",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct rlimit __user *rlim",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	98: syscallinfo.Syscall{
 		Num:     98,
 		Name:    "getrusage",
 		Entry:   "sys_getrusage",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int who",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct rusage __user *ru",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	99: syscallinfo.Syscall{
 		Num:     99,
 		Name:    "sysinfo",
 		Entry:   "sys_sysinfo",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct sysinfo __user *info",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	100: syscallinfo.Syscall{
 		Num:     100,
 		Name:    "times",
 		Entry:   "sys_times",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct tms __user *tbuf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	101: syscallinfo.Syscall{
 		Num:     101,
 		Name:    "ptrace",
 		Entry:   "sys_ptrace",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "long request",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "long pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long addr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long data",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	102: syscallinfo.Syscall{
-		Num:     102,
-		Name:    "getuid",
-		Entry:   "sys_getuid",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        102,
+		Name:       "getuid",
+		Entry:      "sys_getuid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatCreds | syscallinfo.CatPure,
 	},
 	103: syscallinfo.Syscall{
 		Num:     103,
 		Name:    "syslog",
 		Entry:   "sys_syslog",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int type",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	104: syscallinfo.Syscall{
-		Num:     104,
-		Name:    "getgid",
-		Entry:   "sys_getgid",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        104,
+		Name:       "getgid",
+		Entry:      "sys_getgid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatCreds | syscallinfo.CatPure,
 	},
 	105: syscallinfo.Syscall{
 		Num:     105,
 		Name:    "setuid",
 		Entry:   "sys_setuid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "uid_t uid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	106: syscallinfo.Syscall{
 		Num:     106,
 		Name:    "setgid",
 		Entry:   "sys_setgid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "gid_t gid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	107: syscallinfo.Syscall{
-		Num:     107,
-		Name:    "geteuid",
-		Entry:   "sys_geteuid",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        107,
+		Name:       "geteuid",
+		Entry:      "sys_geteuid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatCreds | syscallinfo.CatPure,
 	},
 	108: syscallinfo.Syscall{
-		Num:     108,
-		Name:    "getegid",
-		Entry:   "sys_getegid",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        108,
+		Name:       "getegid",
+		Entry:      "sys_getegid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatCreds | syscallinfo.CatPure,
 	},
 	109: syscallinfo.Syscall{
 		Num:     109,
 		Name:    "setpgid",
 		Entry:   "sys_setpgid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "pid_t pgid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	110: syscallinfo.Syscall{
-		Num:     110,
-		Name:    "getppid",
-		Entry:   "sys_getppid",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        110,
+		Name:       "getppid",
+		Entry:      "sys_getppid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatPure,
 	},
 	111: syscallinfo.Syscall{
-		Num:     111,
-		Name:    "getpgrp",
-		Entry:   "sys_getpgrp",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        111,
+		Name:       "getpgrp",
+		Entry:      "sys_getpgrp",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatPure,
 	},
 	112: syscallinfo.Syscall{
-		Num:     112,
-		Name:    "setsid",
-		Entry:   "sys_setsid",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        112,
+		Name:       "setsid",
+		Entry:      "sys_setsid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
 	},
 	113: syscallinfo.Syscall{
 		Num:     113,
 		Name:    "setreuid",
 		Entry:   "sys_setreuid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "uid_t ruid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "uid_t euid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	114: syscallinfo.Syscall{
 		Num:     114,
 		Name:    "setregid",
 		Entry:   "sys_setregid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "gid_t rgid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "gid_t egid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	115: syscallinfo.Syscall{
 		Num:     115,
 		Name:    "getgroups",
 		Entry:   "sys_getgroups",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int gidsetsize",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "gid_t __user *grouplist",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	116: syscallinfo.Syscall{
 		Num:     116,
 		Name:    "setgroups",
 		Entry:   "sys_setgroups",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int gidsetsize",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "gid_t __user *grouplist",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	117: syscallinfo.Syscall{
 		Num:     117,
 		Name:    "setresuid",
 		Entry:   "sys_setresuid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "uid_t ruid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "uid_t euid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "uid_t suid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	118: syscallinfo.Syscall{
 		Num:     118,
 		Name:    "getresuid",
 		Entry:   "sys_getresuid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "uid_t __user *ruid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "uid_t __user *euid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "uid_t __user *suid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	119: syscallinfo.Syscall{
 		Num:     119,
 		Name:    "setresgid",
 		Entry:   "sys_setresgid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "gid_t rgid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "gid_t egid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "gid_t sgid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	120: syscallinfo.Syscall{
 		Num:     120,
 		Name:    "getresgid",
 		Entry:   "sys_getresgid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "gid_t __user *rgid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "gid_t __user *egid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "gid_t __user *sgid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	121: syscallinfo.Syscall{
 		Num:     121,
 		Name:    "getpgid",
 		Entry:   "sys_getpgid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	122: syscallinfo.Syscall{
 		Num:     122,
 		Name:    "setfsuid",
 		Entry:   "sys_setfsuid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "uid_t uid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	123: syscallinfo.Syscall{
 		Num:     123,
 		Name:    "setfsgid",
 		Entry:   "sys_setfsgid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "gid_t gid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	124: syscallinfo.Syscall{
 		Num:     124,
 		Name:    "getsid",
 		Entry:   "sys_getsid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	125: syscallinfo.Syscall{
 		Num:     125,
 		Name:    "capget",
 		Entry:   "sys_capget",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "cap_user_header_t header",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "cap_user_data_t dataptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	126: syscallinfo.Syscall{
 		Num:     126,
 		Name:    "capset",
 		Entry:   "sys_capset",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "cap_user_header_t header",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "const cap_user_data_t data",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatCreds,
 	},
 	127: syscallinfo.Syscall{
 		Num:     127,
 		Name:    "rt_sigpending",
 		Entry:   "sys_rt_sigpending",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "sigset_t __user *set",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t sigsetsize",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	128: syscallinfo.Syscall{
 		Num:     128,
 		Name:    "rt_sigtimedwait",
 		Entry:   "sys_rt_sigtimedwait",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const sigset_t __user *uthese",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "siginfo_t __user *uinfo",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct timespec __user *uts",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t sigsetsize",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	129: syscallinfo.Syscall{
 		Num:     129,
 		Name:    "rt_sigqueueinfo",
 		Entry:   "sys_rt_sigqueueinfo",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int sig",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "siginfo_t __user *uinfo",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	130: syscallinfo.Syscall{
 		Num:     130,
 		Name:    "rt_sigsuspend",
 		Entry:   "sys_rt_sigsuspend",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "sigset_t __user *unewset",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t sigsetsize",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	131: syscallinfo.Syscall{
 		Num:     131,
 		Name:    "sigaltstack",
 		Entry:   "sys_sigaltstack",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const struct sigaltstack __user *uss",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sigaltstack __user *uoss",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	132: syscallinfo.Syscall{
 		Num:     132,
 		Name:    "utime",
 		Entry:   "sys_utime",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct utimbuf __user *times",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	133: syscallinfo.Syscall{
 		Num:     133,
 		Name:    "mknod",
 		Entry:   "sys_mknod",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "umode_t mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned dev",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
+	},
+	134: syscallinfo.Syscall{
+		Num:        134,
+		Name:       "uselib",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatFile,
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	135: syscallinfo.Syscall{
 		Num:     135,
 		Name:    "personality",
 		Entry:   "sys_personality",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int personality",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	136: syscallinfo.Syscall{
 		Num:     136,
 		Name:    "ustat",
 		Entry:   "sys_ustat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned dev",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct ustat __user *ubuf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatStatfsLike,
 	},
 	137: syscallinfo.Syscall{
 		Num:     137,
 		Name:    "statfs",
 		Entry:   "sys_statfs",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user * path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct statfs __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatStatfs | syscallinfo.CatStatfsLike,
 	},
 	138: syscallinfo.Syscall{
 		Num:     138,
 		Name:    "fstatfs",
 		Entry:   "sys_fstatfs",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct statfs __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatFstatfs | syscallinfo.CatStatfsLike,
 	},
 	139: syscallinfo.Syscall{
 		Num:     139,
 		Name:    "sysfs",
 		Entry:   "sys_sysfs",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int option",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg1",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg2",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	140: syscallinfo.Syscall{
 		Num:     140,
 		Name:    "getpriority",
 		Entry:   "sys_getpriority",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int which",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int who",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	141: syscallinfo.Syscall{
 		Num:     141,
 		Name:    "setpriority",
 		Entry:   "sys_setpriority",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int which",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int who",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int niceval",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	142: syscallinfo.Syscall{
 		Num:     142,
 		Name:    "sched_setparam",
 		Entry:   "sys_sched_setparam",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sched_param __user *param",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	143: syscallinfo.Syscall{
 		Num:     143,
 		Name:    "sched_getparam",
 		Entry:   "sys_sched_getparam",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sched_param __user *param",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	144: syscallinfo.Syscall{
 		Num:     144,
 		Name:    "sched_setscheduler",
 		Entry:   "sys_sched_setscheduler",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int policy",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sched_param __user *param",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	145: syscallinfo.Syscall{
 		Num:     145,
 		Name:    "sched_getscheduler",
 		Entry:   "sys_sched_getscheduler",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	146: syscallinfo.Syscall{
 		Num:     146,
 		Name:    "sched_get_priority_max",
 		Entry:   "sys_sched_get_priority_max",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int policy",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	147: syscallinfo.Syscall{
 		Num:     147,
 		Name:    "sched_get_priority_min",
 		Entry:   "sys_sched_get_priority_min",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int policy",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	148: syscallinfo.Syscall{
 		Num:     148,
 		Name:    "sched_rr_get_interval",
 		Entry:   "sys_sched_rr_get_interval",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *interval",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	149: syscallinfo.Syscall{
 		Num:     149,
 		Name:    "mlock",
 		Entry:   "sys_mlock",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long start",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	150: syscallinfo.Syscall{
 		Num:     150,
 		Name:    "munlock",
 		Entry:   "sys_munlock",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long start",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	151: syscallinfo.Syscall{
 		Num:     151,
 		Name:    "mlockall",
 		Entry:   "sys_mlockall",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	152: syscallinfo.Syscall{
-		Num:     152,
-		Name:    "munlockall",
-		Entry:   "sys_munlockall",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        152,
+		Name:       "munlockall",
+		Entry:      "sys_munlockall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatMemory,
 	},
 	153: syscallinfo.Syscall{
-		Num:     153,
-		Name:    "vhangup",
-		Entry:   "sys_vhangup",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        153,
+		Name:       "vhangup",
+		Entry:      "sys_vhangup",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
 	},
 	154: syscallinfo.Syscall{
 		Num:     154,
 		Name:    "modify_ldt",
 		Entry:   "sys_modify_ldt",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "void __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	155: syscallinfo.Syscall{
 		Num:     155,
 		Name:    "pivot_root",
 		Entry:   "sys_pivot_root",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *new_root",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *put_old",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	156: syscallinfo.Syscall{
-		Num:     156,
-		Name:    "_sysctl",
-		Entry:   "sys_sysctl",
-		Context: 0,
-		Args: []syscallinfo.Argument{
-			{
-				RefCount: 1,
-				Sig:      "struct __sysctl_args __user *args",
-				Context:  0,
-			},
-		},
+		Num:        156,
+		Name:       "_sysctl",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Until:      syscallinfo.KernelVersion{Major: 5, Minor: 5, Patch: 0},
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	157: syscallinfo.Syscall{
 		Num:     157,
 		Name:    "prctl",
 		Entry:   "sys_prctl",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int option",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg2",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg3",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg4",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg5",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	158: syscallinfo.Syscall{
 		Num:     158,
 		Name:    "arch_prctl",
 		Entry:   "sys_arch_prctl",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	159: syscallinfo.Syscall{
 		Num:     159,
 		Name:    "adjtimex",
 		Entry:   "sys_adjtimex",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct timex __user *txc_p",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatClock,
 	},
 	160: syscallinfo.Syscall{
 		Num:     160,
 		Name:    "setrlimit",
 		Entry:   "sys_setrlimit",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int resource",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct rlimit __user *rlim",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	161: syscallinfo.Syscall{
 		Num:     161,
 		Name:    "chroot",
 		Entry:   "sys_chroot",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	162: syscallinfo.Syscall{
-		Num:     162,
-		Name:    "sync",
-		Entry:   "sys_sync",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        162,
+		Name:       "sync",
+		Entry:      "sys_sync",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
 	},
 	163: syscallinfo.Syscall{
 		Num:     163,
 		Name:    "acct",
 		Entry:   "sys_acct",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	164: syscallinfo.Syscall{
 		Num:     164,
 		Name:    "settimeofday",
 		Entry:   "sys_settimeofday",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct timeval __user *tv",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timezone __user *tz",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatClock,
 	},
 	165: syscallinfo.Syscall{
 		Num:     165,
 		Name:    "mount",
 		Entry:   "sys_mount",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "char __user *dev_name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *dir_name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *type",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "void __user *data",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	166: syscallinfo.Syscall{
 		Num:     166,
 		Name:    "umount2",
 		Entry:   "sys_umount",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	167: syscallinfo.Syscall{
 		Num:     167,
 		Name:    "swapon",
 		Entry:   "sys_swapon",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *specialfile",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int swap_flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	168: syscallinfo.Syscall{
 		Num:     168,
 		Name:    "swapoff",
 		Entry:   "sys_swapoff",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *specialfile",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	169: syscallinfo.Syscall{
 		Num:     169,
 		Name:    "reboot",
 		Entry:   "sys_reboot",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int magic1",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int magic2",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int cmd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "void __user *arg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	170: syscallinfo.Syscall{
 		Num:     170,
 		Name:    "sethostname",
 		Entry:   "sys_sethostname",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	171: syscallinfo.Syscall{
 		Num:     171,
 		Name:    "setdomainname",
 		Entry:   "sys_setdomainname",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	172: syscallinfo.Syscall{
 		Num:     172,
 		Name:    "iopl",
 		Entry:   "sys_iopl",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	173: syscallinfo.Syscall{
 		Num:     173,
 		Name:    "ioperm",
 		Entry:   "sys_ioperm",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+	},
+	174: syscallinfo.Syscall{
+		Num:        174,
+		Name:       "create_module",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Until:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 0},
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	175: syscallinfo.Syscall{
 		Num:     175,
 		Name:    "init_module",
 		Entry:   "sys_init_module",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "void __user *umod",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *uargs",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	176: syscallinfo.Syscall{
 		Num:     176,
 		Name:    "delete_module",
 		Entry:   "sys_delete_module",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name_user",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
-			},
-		},
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+	},
+	177: syscallinfo.Syscall{
+		Num:        177,
+		Name:       "get_kernel_syms",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Until:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 0},
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	178: syscallinfo.Syscall{
+		Num:        178,
+		Name:       "query_module",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Until:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 0},
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	179: syscallinfo.Syscall{
 		Num:     179,
 		Name:    "quotactl",
 		Entry:   "sys_quotactl",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int cmd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *special",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "qid_t id",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "void __user *addr",
-				Context:  0,
-			},
-		},
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile,
+	},
+	180: syscallinfo.Syscall{
+		Num:        180,
+		Name:       "nfsservctl",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Until:      syscallinfo.KernelVersion{Major: 3, Minor: 1, Patch: 0},
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	181: syscallinfo.Syscall{
+		Num:        181,
+		Name:       "getpmsg",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	182: syscallinfo.Syscall{
+		Num:        182,
+		Name:       "putpmsg",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	183: syscallinfo.Syscall{
+		Num:        183,
+		Name:       "afs_syscall",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	184: syscallinfo.Syscall{
+		Num:        184,
+		Name:       "tuxcall",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	185: syscallinfo.Syscall{
+		Num:        185,
+		Name:       "security",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	186: syscallinfo.Syscall{
-		Num:     186,
-		Name:    "gettid",
-		Entry:   "sys_gettid",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        186,
+		Name:       "gettid",
+		Entry:      "sys_gettid",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatPure,
 	},
 	187: syscallinfo.Syscall{
 		Num:     187,
 		Name:    "readahead",
 		Entry:   "sys_readahead",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "loff_t offset",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	188: syscallinfo.Syscall{
 		Num:     188,
 		Name:    "setxattr",
 		Entry:   "sys_setxattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const void __user *value",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	189: syscallinfo.Syscall{
 		Num:     189,
 		Name:    "lsetxattr",
 		Entry:   "sys_lsetxattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const void __user *value",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	190: syscallinfo.Syscall{
 		Num:     190,
 		Name:    "fsetxattr",
 		Entry:   "sys_fsetxattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const void __user *value",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	191: syscallinfo.Syscall{
 		Num:     191,
 		Name:    "getxattr",
 		Entry:   "sys_getxattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "void __user *value",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	192: syscallinfo.Syscall{
 		Num:     192,
 		Name:    "lgetxattr",
 		Entry:   "sys_lgetxattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "void __user *value",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	193: syscallinfo.Syscall{
 		Num:     193,
 		Name:    "fgetxattr",
 		Entry:   "sys_fgetxattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "void __user *value",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	194: syscallinfo.Syscall{
 		Num:     194,
 		Name:    "listxattr",
 		Entry:   "sys_listxattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *list",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	195: syscallinfo.Syscall{
 		Num:     195,
 		Name:    "llistxattr",
 		Entry:   "sys_llistxattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *list",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	196: syscallinfo.Syscall{
 		Num:     196,
 		Name:    "flistxattr",
 		Entry:   "sys_flistxattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *list",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	197: syscallinfo.Syscall{
 		Num:     197,
 		Name:    "removexattr",
 		Entry:   "sys_removexattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	198: syscallinfo.Syscall{
 		Num:     198,
 		Name:    "lremovexattr",
 		Entry:   "sys_lremovexattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
 	},
 	199: syscallinfo.Syscall{
 		Num:     199,
 		Name:    "fremovexattr",
 		Entry:   "sys_fremovexattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	200: syscallinfo.Syscall{
 		Num:     200,
 		Name:    "tkill",
 		Entry:   "sys_tkill",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int sig",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	201: syscallinfo.Syscall{
 		Num:     201,
 		Name:    "time",
 		Entry:   "sys_time",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "time_t __user *tloc",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatClock,
 	},
 	202: syscallinfo.Syscall{
 		Num:     202,
 		Name:    "futex",
 		Entry:   "sys_futex",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "u32 __user *uaddr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int op",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "u32 val",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *utime",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "u32 __user *uaddr2",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "u32 val3",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	203: syscallinfo.Syscall{
 		Num:     203,
 		Name:    "sched_setaffinity",
 		Entry:   "sys_sched_setaffinity",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "unsigned long __user *user_mask_ptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	204: syscallinfo.Syscall{
 		Num:     204,
 		Name:    "sched_getaffinity",
 		Entry:   "sys_sched_getaffinity",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "unsigned long __user *user_mask_ptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+	},
+	205: syscallinfo.Syscall{
+		Num:        205,
+		Name:       "set_thread_area",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	206: syscallinfo.Syscall{
 		Num:     206,
 		Name:    "io_setup",
 		Entry:   "sys_io_setup",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned nr_reqs",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "aio_context_t __user *ctx",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	207: syscallinfo.Syscall{
 		Num:     207,
 		Name:    "io_destroy",
 		Entry:   "sys_io_destroy",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "aio_context_t ctx",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	208: syscallinfo.Syscall{
 		Num:     208,
 		Name:    "io_getevents",
 		Entry:   "sys_io_getevents",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "aio_context_t ctx_id",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "long min_nr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "long nr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct io_event __user *events",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *timeout",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	209: syscallinfo.Syscall{
 		Num:     209,
 		Name:    "io_submit",
 		Entry:   "sys_io_submit",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "aio_context_t",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "long",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 2,
 				Sig:      "struct iocb __user * __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	210: syscallinfo.Syscall{
 		Num:     210,
 		Name:    "io_cancel",
 		Entry:   "sys_io_cancel",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "aio_context_t ctx_id",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct iocb __user *iocb",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct io_event __user *result",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+	},
+	211: syscallinfo.Syscall{
+		Num:        211,
+		Name:       "get_thread_area",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	212: syscallinfo.Syscall{
-		Num:     212,
-		Name:    "lookup_dcookie",
-		Entry:   "sys_lookup_dcookie",
-		Context: 0,
-		Args: []syscallinfo.Argument{
-			{
-				RefCount: 0,
-				Sig:      "u64 cookie64",
-				Context:  0,
-			},
-			{
-				RefCount: 1,
-				Sig:      "char __user *buf",
-				Context:  0,
-			},
-			{
-				RefCount: 0,
-				Sig:      "size_t len",
-				Context:  0,
-			},
-		},
+		Num:        212,
+		Name:       "lookup_dcookie",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Until:      syscallinfo.KernelVersion{Major: 6, Minor: 8, Patch: 0},
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	213: syscallinfo.Syscall{
 		Num:     213,
 		Name:    "epoll_create",
 		Entry:   "sys_epoll_create",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+	},
+	214: syscallinfo.Syscall{
+		Num:        214,
+		Name:       "epoll_ctl_old",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
+	},
+	215: syscallinfo.Syscall{
+		Num:        215,
+		Name:       "epoll_wait_old",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	216: syscallinfo.Syscall{
 		Num:     216,
 		Name:    "remap_file_pages",
 		Entry:   "sys_remap_file_pages",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long start",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long prot",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long pgoff",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
 	},
 	217: syscallinfo.Syscall{
 		Num:     217,
 		Name:    "getdents64",
 		Entry:   "sys_getdents64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct linux_dirent64 __user *dirent",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	218: syscallinfo.Syscall{
 		Num:     218,
 		Name:    "set_tid_address",
 		Entry:   "sys_set_tid_address",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "int __user *tidptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	219: syscallinfo.Syscall{
-		Num:     219,
-		Name:    "restart_syscall",
-		Entry:   "sys_restart_syscall",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        219,
+		Name:       "restart_syscall",
+		Entry:      "sys_restart_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
 	},
 	220: syscallinfo.Syscall{
 		Num:     220,
 		Name:    "semtimedop",
 		Entry:   "sys_semtimedop",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int semid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sembuf __user *sops",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned nsops",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct timespec __user *timeout",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatIPC,
 	},
 	221: syscallinfo.Syscall{
 		Num:     221,
 		Name:    "fadvise64",
 		Entry:   "sys_fadvise64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "loff_t offset",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int advice",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	222: syscallinfo.Syscall{
 		Num:     222,
 		Name:    "timer_create",
 		Entry:   "sys_timer_create",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "clockid_t which_clock",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sigevent __user *timer_event_spec",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "timer_t __user * created_timer_id",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	223: syscallinfo.Syscall{
 		Num:     223,
 		Name:    "timer_settime",
 		Entry:   "sys_timer_settime",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "timer_t timer_id",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct itimerspec __user *new_setting",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct itimerspec __user *old_setting",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	224: syscallinfo.Syscall{
 		Num:     224,
 		Name:    "timer_gettime",
 		Entry:   "sys_timer_gettime",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "timer_t timer_id",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct itimerspec __user *setting",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	225: syscallinfo.Syscall{
 		Num:     225,
 		Name:    "timer_getoverrun",
 		Entry:   "sys_timer_getoverrun",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "timer_t timer_id",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	226: syscallinfo.Syscall{
 		Num:     226,
 		Name:    "timer_delete",
 		Entry:   "sys_timer_delete",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "timer_t timer_id",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	227: syscallinfo.Syscall{
 		Num:     227,
 		Name:    "clock_settime",
 		Entry:   "sys_clock_settime",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "clockid_t which_clock",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct timespec __user *tp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatClock,
 	},
 	228: syscallinfo.Syscall{
 		Num:     228,
 		Name:    "clock_gettime",
 		Entry:   "sys_clock_gettime",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "clockid_t which_clock",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *tp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatClock,
 	},
 	229: syscallinfo.Syscall{
 		Num:     229,
 		Name:    "clock_getres",
 		Entry:   "sys_clock_getres",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "clockid_t which_clock",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *tp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatClock,
 	},
 	230: syscallinfo.Syscall{
 		Num:     230,
 		Name:    "clock_nanosleep",
 		Entry:   "sys_clock_nanosleep",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "clockid_t which_clock",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct timespec __user *rqtp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *rmtp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
 	},
 	231: syscallinfo.Syscall{
 		Num:     231,
 		Name:    "exit_group",
 		Entry:   "sys_exit_group",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int error_code",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatProcess,
 	},
 	232: syscallinfo.Syscall{
 		Num:     232,
 		Name:    "epoll_wait",
 		Entry:   "sys_epoll_wait",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int epfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct epoll_event __user *events",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int maxevents",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int timeout",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	233: syscallinfo.Syscall{
 		Num:     233,
 		Name:    "epoll_ctl",
 		Entry:   "sys_epoll_ctl",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int epfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int op",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct epoll_event __user *event",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
 	},
 	234: syscallinfo.Syscall{
 		Num:     234,
 		Name:    "tgkill",
 		Entry:   "sys_tgkill",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int tgid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int sig",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
 	},
 	235: syscallinfo.Syscall{
 		Num:     235,
 		Name:    "utimes",
 		Entry:   "sys_utimes",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timeval __user *utimes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile,
+	},
+	236: syscallinfo.Syscall{
+		Num:        236,
+		Name:       "vserver",
+		Entry:      "sys_ni_syscall",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Status:     syscallinfo.SyscallNotImplemented,
 	},
 	237: syscallinfo.Syscall{
 		Num:     237,
 		Name:    "mbind",
 		Entry:   "sys_mbind",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long start",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const unsigned long __user *nmask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long maxnode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
 	},
 	238: syscallinfo.Syscall{
 		Num:     238,
 		Name:    "set_mempolicy",
 		Entry:   "sys_set_mempolicy",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const unsigned long __user *nmask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long maxnode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
 	},
 	239: syscallinfo.Syscall{
 		Num:     239,
 		Name:    "get_mempolicy",
 		Entry:   "sys_get_mempolicy",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "int __user *policy",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "unsigned long __user *nmask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long maxnode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long addr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
 	},
 	240: syscallinfo.Syscall{
 		Num:     240,
 		Name:    "mq_open",
 		Entry:   "sys_mq_open",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int oflag",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "umode_t mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct mq_attr __user *attr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
 	},
 	241: syscallinfo.Syscall{
 		Num:     241,
 		Name:    "mq_unlink",
 		Entry:   "sys_mq_unlink",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
 	},
 	242: syscallinfo.Syscall{
 		Num:     242,
 		Name:    "mq_timedsend",
 		Entry:   "sys_mq_timedsend",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "mqd_t mqdes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *msg_ptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t msg_len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int msg_prio",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct timespec __user *abs_timeout",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
 	},
 	243: syscallinfo.Syscall{
 		Num:     243,
 		Name:    "mq_timedreceive",
 		Entry:   "sys_mq_timedreceive",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "mqd_t mqdes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *msg_ptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t msg_len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "unsigned int __user *msg_prio",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct timespec __user *abs_timeout",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
 	},
 	244: syscallinfo.Syscall{
 		Num:     244,
 		Name:    "mq_notify",
 		Entry:   "sys_mq_notify",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "mqd_t mqdes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct sigevent __user *notification",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
 	},
 	245: syscallinfo.Syscall{
 		Num:     245,
 		Name:    "mq_getsetattr",
 		Entry:   "sys_mq_getsetattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "mqd_t mqdes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct mq_attr __user *mqstat",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct mq_attr __user *omqstat",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 6},
 	},
 	246: syscallinfo.Syscall{
 		Num:     246,
 		Name:    "kexec_load",
 		Entry:   "sys_kexec_load",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long entry",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long nr_segments",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct kexec_segment __user *segments",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 13},
 	},
 	247: syscallinfo.Syscall{
 		Num:     247,
 		Name:    "waitid",
 		Entry:   "sys_waitid",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int which",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct siginfo __user *infop",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int options",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct rusage __user *ru",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatProcess,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 9},
 	},
 	248: syscallinfo.Syscall{
 		Num:     248,
 		Name:    "add_key",
 		Entry:   "sys_add_key",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *_type",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *_description",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const void __user *_payload",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t plen",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "key_serial_t destringid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 10},
 	},
 	249: syscallinfo.Syscall{
 		Num:     249,
 		Name:    "request_key",
 		Entry:   "sys_request_key",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *_type",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *_description",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *_callout_info",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "key_serial_t destringid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 10},
 	},
 	250: syscallinfo.Syscall{
 		Num:     250,
 		Name:    "keyctl",
 		Entry:   "sys_keyctl",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int cmd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg2",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg3",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg4",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long arg5",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 10},
 	},
 	251: syscallinfo.Syscall{
 		Num:     251,
 		Name:    "ioprio_set",
 		Entry:   "sys_ioprio_set",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int which",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int who",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int ioprio",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 13},
 	},
 	252: syscallinfo.Syscall{
 		Num:     252,
 		Name:    "ioprio_get",
 		Entry:   "sys_ioprio_get",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int which",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int who",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 13},
 	},
 	253: syscallinfo.Syscall{
-		Num:     253,
-		Name:    "inotify_init",
-		Entry:   "sys_inotify_init",
-		Context: 0,
-		Args:    []syscallinfo.Argument{},
+		Num:        253,
+		Name:       "inotify_init",
+		Entry:      "sys_inotify_init",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 13},
 	},
 	254: syscallinfo.Syscall{
 		Num:     254,
 		Name:    "inotify_add_watch",
 		Entry:   "sys_inotify_add_watch",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "u32 mask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 13},
 	},
 	255: syscallinfo.Syscall{
 		Num:     255,
 		Name:    "inotify_rm_watch",
 		Entry:   "sys_inotify_rm_watch",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "__s32 wd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 13},
 	},
 	256: syscallinfo.Syscall{
 		Num:     256,
 		Name:    "migrate_pages",
 		Entry:   "sys_migrate_pages",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long maxnode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const unsigned long __user *from",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const unsigned long __user *to",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	257: syscallinfo.Syscall{
 		Num:     257,
 		Name:    "openat",
 		Entry:   "sys_openat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "umode_t mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	258: syscallinfo.Syscall{
 		Num:     258,
 		Name:    "mkdirat",
 		Entry:   "sys_mkdirat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user * pathname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "umode_t mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	259: syscallinfo.Syscall{
 		Num:     259,
 		Name:    "mknodat",
 		Entry:   "sys_mknodat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user * filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "umode_t mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned dev",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	260: syscallinfo.Syscall{
 		Num:     260,
 		Name:    "fchownat",
 		Entry:   "sys_fchownat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "uid_t user",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "gid_t group",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flag",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	261: syscallinfo.Syscall{
 		Num:     261,
 		Name:    "futimesat",
 		Entry:   "sys_futimesat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timeval __user *utimes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	262: syscallinfo.Syscall{
 		Num:     262,
 		Name:    "newfstatat",
 		Entry:   "sys_newfstatat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct stat __user *statbuf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flag",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc | syscallinfo.CatFstat | syscallinfo.CatStatLike,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	263: syscallinfo.Syscall{
 		Num:     263,
 		Name:    "unlinkat",
 		Entry:   "sys_unlinkat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user * pathname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flag",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	264: syscallinfo.Syscall{
 		Num:     264,
 		Name:    "renameat",
 		Entry:   "sys_renameat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int olddfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user * oldname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int newdfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user * newname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	265: syscallinfo.Syscall{
 		Num:     265,
 		Name:    "linkat",
 		Entry:   "sys_linkat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int olddfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *oldname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int newdfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *newname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	266: syscallinfo.Syscall{
 		Num:     266,
 		Name:    "symlinkat",
 		Entry:   "sys_symlinkat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user * oldname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int newdfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user * newname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	267: syscallinfo.Syscall{
 		Num:     267,
 		Name:    "readlinkat",
 		Entry:   "sys_readlinkat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *path",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int bufsiz",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	268: syscallinfo.Syscall{
 		Num:     268,
 		Name:    "fchmodat",
 		Entry:   "sys_fchmodat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user * filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "umode_t mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	269: syscallinfo.Syscall{
 		Num:     269,
 		Name:    "faccessat",
 		Entry:   "sys_faccessat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	270: syscallinfo.Syscall{
 		Num:     270,
 		Name:    "pselect6",
 		Entry:   "sys_pselect6",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int n",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "fd_set __user *inp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "fd_set __user *outp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "fd_set __user *exp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *tsp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "void __user *sig",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	271: syscallinfo.Syscall{
 		Num:     271,
 		Name:    "ppoll",
 		Entry:   "sys_ppoll",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct pollfd __user *ufds",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int nfds",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *tsp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const sigset_t __user *sigmask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t sigsetsize",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	272: syscallinfo.Syscall{
 		Num:     272,
 		Name:    "unshare",
 		Entry:   "sys_unshare",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long unshare_flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatProcess,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16},
 	},
 	273: syscallinfo.Syscall{
 		Num:     273,
 		Name:    "set_robust_list",
 		Entry:   "sys_set_robust_list",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct robust_list_head __user *head",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 17},
 	},
 	274: syscallinfo.Syscall{
 		Num:     274,
 		Name:    "get_robust_list",
 		Entry:   "sys_get_robust_list",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 2,
 				Sig:      "struct robust_list_head __user * __user *head_ptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "size_t __user *len_ptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 17},
 	},
 	275: syscallinfo.Syscall{
 		Num:     275,
 		Name:    "splice",
 		Entry:   "sys_splice",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd_in",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "loff_t __user *off_in",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int fd_out",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "loff_t __user *off_out",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 17},
 	},
 	276: syscallinfo.Syscall{
 		Num:     276,
 		Name:    "tee",
 		Entry:   "sys_tee",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fdin",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int fdout",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 17},
 	},
 	277: syscallinfo.Syscall{
 		Num:     277,
 		Name:    "sync_file_range",
 		Entry:   "sys_sync_file_range",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "loff_t offset",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "loff_t nbytes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 17},
 	},
 	278: syscallinfo.Syscall{
 		Num:     278,
 		Name:    "vmsplice",
 		Entry:   "sys_vmsplice",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct iovec __user *iov",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long nr_segs",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 17},
 	},
 	279: syscallinfo.Syscall{
 		Num:     279,
 		Name:    "move_pages",
 		Entry:   "sys_move_pages",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long nr_pages",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 2,
 				Sig:      "const void __user * __user *pages",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const int __user *nodes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "int __user *status",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 18},
 	},
 	280: syscallinfo.Syscall{
 		Num:     280,
 		Name:    "utimensat",
 		Entry:   "sys_utimensat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *utimes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 22},
 	},
 	281: syscallinfo.Syscall{
 		Num:     281,
 		Name:    "epoll_pwait",
 		Entry:   "sys_epoll_pwait",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int epfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct epoll_event __user *events",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int maxevents",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int timeout",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const sigset_t __user *sigmask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t sigsetsize",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 19},
 	},
 	282: syscallinfo.Syscall{
 		Num:     282,
 		Name:    "signalfd",
 		Entry:   "sys_signalfd",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int ufd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "sigset_t __user *user_mask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t sizemask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatSignal,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 22},
 	},
 	283: syscallinfo.Syscall{
 		Num:     283,
 		Name:    "timerfd_create",
 		Entry:   "sys_timerfd_create",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int clockid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 25},
 	},
 	284: syscallinfo.Syscall{
 		Num:     284,
 		Name:    "eventfd",
 		Entry:   "sys_eventfd",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 22},
 	},
 	285: syscallinfo.Syscall{
 		Num:     285,
 		Name:    "fallocate",
 		Entry:   "sys_fallocate",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int mode",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "loff_t offset",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "loff_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 23},
 	},
 	286: syscallinfo.Syscall{
 		Num:     286,
 		Name:    "timerfd_settime",
 		Entry:   "sys_timerfd_settime",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int ufd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct itimerspec __user *utmr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct itimerspec __user *otmr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 25},
 	},
 	287: syscallinfo.Syscall{
 		Num:     287,
 		Name:    "timerfd_gettime",
 		Entry:   "sys_timerfd_gettime",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int ufd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct itimerspec __user *otmr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 25},
 	},
 	288: syscallinfo.Syscall{
 		Num:     288,
 		Name:    "accept4",
 		Entry:   "sys_accept4",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sockaddr __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "int __user *",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 28},
 	},
 	289: syscallinfo.Syscall{
 		Num:     289,
 		Name:    "signalfd4",
 		Entry:   "sys_signalfd4",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int ufd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "sigset_t __user *user_mask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t sizemask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatSignal,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 27},
 	},
 	290: syscallinfo.Syscall{
 		Num:     290,
 		Name:    "eventfd2",
 		Entry:   "sys_eventfd2",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 27},
 	},
 	291: syscallinfo.Syscall{
 		Num:     291,
 		Name:    "epoll_create1",
 		Entry:   "sys_epoll_create1",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 27},
 	},
 	292: syscallinfo.Syscall{
 		Num:     292,
 		Name:    "dup3",
 		Entry:   "sys_dup3",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int oldfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int newfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 27},
 	},
 	293: syscallinfo.Syscall{
 		Num:     293,
 		Name:    "pipe2",
 		Entry:   "sys_pipe2",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "int __user *fildes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 27},
 	},
 	294: syscallinfo.Syscall{
 		Num:     294,
 		Name:    "inotify_init1",
 		Entry:   "sys_inotify_init1",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 27},
 	},
 	295: syscallinfo.Syscall{
 		Num:     295,
 		Name:    "preadv",
 		Entry:   "sys_preadv",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct iovec __user *vec",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long vlen",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long pos_l",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long pos_h",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 30},
 	},
 	296: syscallinfo.Syscall{
 		Num:     296,
 		Name:    "pwritev",
 		Entry:   "sys_pwritev",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct iovec __user *vec",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long vlen",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long pos_l",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long pos_h",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 30},
 	},
 	297: syscallinfo.Syscall{
 		Num:     297,
 		Name:    "rt_tgsigqueueinfo",
 		Entry:   "sys_rt_tgsigqueueinfo",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t tgid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int sig",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "siginfo_t __user *uinfo",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 31},
 	},
 	298: syscallinfo.Syscall{
 		Num:     298,
 		Name:    "perf_event_open",
 		Entry:   "sys_perf_event_open",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "struct perf_event_attr __user *attr_uptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int cpu",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int group_fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 31},
 	},
 	299: syscallinfo.Syscall{
 		Num:     299,
 		Name:    "recvmmsg",
 		Entry:   "sys_recvmmsg",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct mmsghdr __user *msg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int vlen",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timespec __user *timeout",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 33},
 	},
 	300: syscallinfo.Syscall{
 		Num:     300,
 		Name:    "fanotify_init",
 		Entry:   "sys_fanotify_init",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int event_f_flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 37},
 	},
 	301: syscallinfo.Syscall{
 		Num:     301,
 		Name:    "fanotify_mark",
 		Entry:   "sys_fanotify_mark",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fanotify_fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "u64 mask",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *pathname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 37},
 	},
 	302: syscallinfo.Syscall{
 		Num:     302,
 		Name:    "prlimit64",
 		Entry:   "sys_prlimit64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int resource",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct rlimit64 __user *new_rlim",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct rlimit64 __user *old_rlim",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 36},
 	},
 	303: syscallinfo.Syscall{
 		Num:     303,
 		Name:    "name_to_handle_at",
 		Entry:   "sys_name_to_handle_at",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *name",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct file_handle __user *handle",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "int __user *mnt_id",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flag",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 39},
 	},
 	304: syscallinfo.Syscall{
 		Num:     304,
 		Name:    "open_by_handle_at",
 		Entry:   "sys_open_by_handle_at",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int mountdirfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct file_handle __user *handle",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 39},
 	},
 	305: syscallinfo.Syscall{
 		Num:     305,
 		Name:    "clock_adjtime",
 		Entry:   "sys_clock_adjtime",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "clockid_t which_clock",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct timex __user *tx",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatClock,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 39},
 	},
 	306: syscallinfo.Syscall{
 		Num:     306,
 		Name:    "syncfs",
 		Entry:   "sys_syncfs",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 39},
 	},
 	307: syscallinfo.Syscall{
 		Num:     307,
 		Name:    "sendmmsg",
 		Entry:   "sys_sendmmsg",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct mmsghdr __user *msg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int vlen",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 0, Patch: 0},
 	},
 	308: syscallinfo.Syscall{
 		Num:     308,
 		Name:    "setns",
 		Entry:   "sys_setns",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int nstype",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 0, Patch: 0},
 	},
 	309: syscallinfo.Syscall{
 		Num:     309,
 		Name:    "getcpu",
 		Entry:   "sys_getcpu",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "unsigned __user *cpu",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "unsigned __user *node",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct getcpu_cache __user *cache",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 19},
 	},
 	310: syscallinfo.Syscall{
 		Num:     310,
 		Name:    "process_vm_readv",
 		Entry:   "sys_process_vm_readv",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct iovec __user *lvec",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long liovcnt",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct iovec __user *rvec",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long riovcnt",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 2, Patch: 0},
 	},
 	311: syscallinfo.Syscall{
 		Num:     311,
 		Name:    "process_vm_writev",
 		Entry:   "sys_process_vm_writev",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct iovec __user *lvec",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long liovcnt",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct iovec __user *rvec",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long riovcnt",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 2, Patch: 0},
 	},
 	312: syscallinfo.Syscall{
 		Num:     312,
 		Name:    "kcmp",
 		Entry:   "sys_kcmp",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid1",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid2",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int type",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long idx1",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long idx2",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 5, Patch: 0},
 	},
 	313: syscallinfo.Syscall{
 		Num:     313,
 		Name:    "finit_module",
 		Entry:   "sys_finit_module",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *uargs",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 8, Patch: 0},
 	},
 	314: syscallinfo.Syscall{
 		Num:     314,
 		Name:    "sched_setattr",
 		Entry:   "sys_sched_setattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sched_attr __user *attr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 14, Patch: 0},
 	},
 	315: syscallinfo.Syscall{
 		Num:     315,
 		Name:    "sched_getattr",
 		Entry:   "sys_sched_getattr",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct sched_attr __user *attr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 14, Patch: 0},
 	},
 	316: syscallinfo.Syscall{
 		Num:     316,
 		Name:    "renameat2",
 		Entry:   "sys_renameat2",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int olddfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *oldname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int newdfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *newname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 15, Patch: 0},
 	},
 	317: syscallinfo.Syscall{
 		Num:     317,
 		Name:    "seccomp",
 		Entry:   "sys_seccomp",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned int op",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *uargs",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 17, Patch: 0},
 	},
 	318: syscallinfo.Syscall{
 		Num:     318,
 		Name:    "getrandom",
 		Entry:   "sys_getrandom",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "char __user *buf",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "size_t count",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 17, Patch: 0},
 	},
 	319: syscallinfo.Syscall{
 		Num:     319,
 		Name:    "memfd_create",
 		Entry:   "sys_memfd_create",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
 				Sig:      "const char __user *uname_ptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 17, Patch: 0},
 	},
 	320: syscallinfo.Syscall{
 		Num:     320,
 		Name:    "kexec_file_load",
 		Entry:   "sys_kexec_file_load",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int kernel_fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int initrd_fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long cmdline_len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *cmdline_ptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 17, Patch: 0},
 	},
 	321: syscallinfo.Syscall{
 		Num:     321,
 		Name:    "bpf",
 		Entry:   "sys_bpf",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int cmd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "union bpf_attr *attr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int size",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 18, Patch: 0},
 	},
 	322: syscallinfo.Syscall{
 		Num:     322,
 		Name:    "execveat",
 		Entry:   "sys_execveat",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int dfd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const char __user *filename",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 2,
 				Sig:      "const char __user *const __user *argv",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 2,
 				Sig:      "const char __user *const __user *envp",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc | syscallinfo.CatProcess,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 19, Patch: 0},
 	},
-	512: syscallinfo.Syscall{
-		Num:     512,
-		Name:    "rt_sigaction",
-		Entry:   "compat_sys_rt_sigaction",
-		Context: 0,
+	323: syscallinfo.Syscall{
+		Num:     323,
+		Name:    "userfaultfd",
+		Entry:   "sys_userfaultfd",
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
-				Sig:      "int",
-				Context:  0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
 			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 3, Patch: 0},
+	},
+	324: syscallinfo.Syscall{
+		Num:     324,
+		Name:    "membarrier",
+		Entry:   "sys_membarrier",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
 			{
-				RefCount: 1,
-				Sig:      "const struct compat_sigaction __user *",
-				Context:  0,
+				RefCount: 0,
+				Sig:      "int cmd",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
-				RefCount: 1,
-				Sig:      "struct compat_sigaction __user *",
-				Context:  0,
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "compat_size_t",
-				Context:  0,
+				Sig:      "int cpu_id",
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 3, Patch: 0},
 	},
-	514: syscallinfo.Syscall{
-		Num:     514,
-		Name:    "ioctl",
-		Entry:   "compat_sys_ioctl",
-		Context: 0,
+	325: syscallinfo.Syscall{
+		Num:     325,
+		Name:    "mlock2",
+		Entry:   "sys_mlock2",
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
-				Sig:      "unsigned int fd",
-				Context:  0,
+				Sig:      "unsigned long start",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "unsigned int cmd",
-				Context:  0,
+				Sig:      "size_t len",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "compat_ulong_t arg",
-				Context:  0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 4, Patch: 0},
 	},
-	515: syscallinfo.Syscall{
-		Num:     515,
-		Name:    "readv",
-		Entry:   "compat_sys_readv",
-		Context: 0,
+	326: syscallinfo.Syscall{
+		Num:     326,
+		Name:    "copy_file_range",
+		Entry:   "sys_copy_file_range",
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
-				Sig:      "compat_ulong_t fd",
-				Context:  0,
+				Sig:      "int fd_in",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
-				Sig:      "const struct compat_iovec __user *vec",
-				Context:  0,
+				Sig:      "loff_t __user *off_in",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "compat_ulong_t vlen",
-				Context:  0,
+				Sig:      "int fd_out",
+				Context:  syscallinfo.CtxNone,
 			},
-		},
-	},
-	516: syscallinfo.Syscall{
-		Num:     516,
-		Name:    "writev",
-		Entry:   "compat_sys_writev",
-		Context: 0,
-		Args: []syscallinfo.Argument{
 			{
-				RefCount: 0,
-				Sig:      "compat_ulong_t fd",
-				Context:  0,
+				RefCount: 1,
+				Sig:      "loff_t __user *off_out",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
-				RefCount: 1,
-				Sig:      "const struct compat_iovec __user *vec",
-				Context:  0,
+				RefCount: 0,
+				Sig:      "size_t len",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "compat_ulong_t vlen",
-				Context:  0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 5, Patch: 0},
 	},
-	517: syscallinfo.Syscall{
-		Num:     517,
-		Name:    "recvfrom",
-		Entry:   "compat_sys_recvfrom",
-		Context: 0,
+	327: syscallinfo.Syscall{
+		Num:     327,
+		Name:    "preadv2",
+		Entry:   "sys_preadv2",
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
-				Sig:      "int fd",
-				Context:  0,
+				Sig:      "unsigned long fd",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
-				Sig:      "void __user *buf",
-				Context:  0,
+				Sig:      "const struct iovec __user *vec",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "compat_size_t len",
-				Context:  0,
+				Sig:      "unsigned long vlen",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "unsigned flags",
-				Context:  0,
+				Sig:      "unsigned long pos_l",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
-				RefCount: 1,
-				Sig:      "struct sockaddr __user *addr",
-				Context:  0,
+				RefCount: 0,
+				Sig:      "unsigned long pos_h",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
-				RefCount: 1,
-				Sig:      "int __user *addrlen",
-				Context:  0,
+				RefCount: 0,
+				Sig:      "rwf_t flags",
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 6, Patch: 0},
 	},
-	518: syscallinfo.Syscall{
-		Num:     518,
-		Name:    "sendmsg",
-		Entry:   "compat_sys_sendmsg",
-		Context: 0,
+	328: syscallinfo.Syscall{
+		Num:     328,
+		Name:    "pwritev2",
+		Entry:   "sys_pwritev2",
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
-				Sig:      "int fd",
-				Context:  0,
+				Sig:      "unsigned long fd",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
-				Sig:      "struct compat_msghdr __user *msg",
-				Context:  0,
+				Sig:      "const struct iovec __user *vec",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "unsigned flags",
-				Context:  0,
+				Sig:      "unsigned long vlen",
+				Context:  syscallinfo.CtxNone,
 			},
-		},
-	},
-	519: syscallinfo.Syscall{
-		Num:     519,
-		Name:    "recvmsg",
-		Entry:   "compat_sys_recvmsg",
-		Context: 0,
-		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
-				Sig:      "int fd",
-				Context:  0,
+				Sig:      "unsigned long pos_l",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
-				RefCount: 1,
-				Sig:      "struct compat_msghdr __user *msg",
-				Context:  0,
+				RefCount: 0,
+				Sig:      "unsigned long pos_h",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "unsigned int flags",
-				Context:  0,
+				Sig:      "rwf_t flags",
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 6, Patch: 0},
 	},
-	521: syscallinfo.Syscall{
-		Num:     521,
-		Name:    "ptrace",
-		Entry:   "compat_sys_ptrace",
-		Context: 0,
+	329: syscallinfo.Syscall{
+		Num:     329,
+		Name:    "pkey_mprotect",
+		Entry:   "sys_pkey_mprotect",
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
-				Sig:      "compat_long_t request",
-				Context:  0,
+				Sig:      "unsigned long start",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "compat_long_t pid",
-				Context:  0,
+				Sig:      "size_t len",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "compat_long_t addr",
-				Context:  0,
+				Sig:      "unsigned long prot",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "compat_long_t data",
-				Context:  0,
+				Sig:      "int pkey",
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 9, Patch: 0},
 	},
-	522: syscallinfo.Syscall{
-		Num:     522,
-		Name:    "rt_sigpending",
-		Entry:   "compat_sys_rt_sigpending",
-		Context: 0,
+	330: syscallinfo.Syscall{
+		Num:     330,
+		Name:    "pkey_alloc",
+		Entry:   "sys_pkey_alloc",
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
-				RefCount: 1,
-				Sig:      "compat_sigset_t __user *uset",
-				Context:  0,
+				RefCount: 0,
+				Sig:      "unsigned long flags",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "compat_size_t sigsetsize",
-				Context:  0,
+				Sig:      "unsigned long init_val",
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 9, Patch: 0},
 	},
-	523: syscallinfo.Syscall{
-		Num:     523,
-		Name:    "rt_sigtimedwait",
-		Entry:   "compat_sys_rt_sigtimedwait",
-		Context: 0,
+	331: syscallinfo.Syscall{
+		Num:     331,
+		Name:    "pkey_free",
+		Entry:   "sys_pkey_free",
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
-				RefCount: 1,
-				Sig:      "compat_sigset_t __user *uthese",
-				Context:  0,
+				RefCount: 0,
+				Sig:      "int pkey",
+				Context:  syscallinfo.CtxNone,
 			},
+		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 9, Patch: 0},
+	},
+	332: syscallinfo.Syscall{
+		Num:     332,
+		Name:    "statx",
+		Entry:   "sys_statx",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
 			{
-				RefCount: 1,
-				Sig:      "struct compat_siginfo __user *uinfo",
-				Context:  0,
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
-				Sig:      "struct compat_timespec __user *uts",
-				Context:  0,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "compat_size_t sigsetsize",
-				Context:  0,
+				Sig:      "unsigned flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned mask",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct statx __user *buffer",
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc | syscallinfo.CatStatLike,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 11, Patch: 0},
 	},
-	524: syscallinfo.Syscall{
-		Num:     524,
-		Name:    "rt_sigqueueinfo",
-		Entry:   "compat_sys_rt_sigqueueinfo",
-		Context: 0,
+	333: syscallinfo.Syscall{
+		Num:     333,
+		Name:    "io_pgetevents",
+		Entry:   "sys_io_pgetevents",
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
-				Sig:      "compat_pid_t pid",
-				Context:  0,
+				Sig:      "aio_context_t ctx_id",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "int sig",
-				Context:  0,
+				Sig:      "long min_nr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "long nr",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
-				Sig:      "struct compat_siginfo __user *uinfo",
-				Context:  0,
+				Sig:      "struct io_event __user *events",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __kernel_timespec __user *timeout",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct __aio_sigset __user *sig",
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 18, Patch: 0},
 	},
-	525: syscallinfo.Syscall{
-		Num:     525,
-		Name:    "sigaltstack",
-		Entry:   "compat_sys_sigaltstack",
-		Context: 0,
+	334: syscallinfo.Syscall{
+		Num:     334,
+		Name:    "rseq",
+		Entry:   "sys_rseq",
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 1,
-				Sig:      "const compat_stack_t __user *uss_ptr",
-				Context:  0,
+				Sig:      "struct rseq __user *rseq",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
-				RefCount: 1,
-				Sig:      "compat_stack_t __user *uoss_ptr",
-				Context:  0,
+				RefCount: 0,
+				Sig:      "u32 rseq_len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "u32 sig",
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 18, Patch: 0},
 	},
-	526: syscallinfo.Syscall{
-		Num:     526,
-		Name:    "timer_create",
-		Entry:   "compat_sys_timer_create",
-		Context: 0,
+	335: syscallinfo.Syscall{
+		Num:        335,
+		Name:       "uretprobe",
+		Entry:      "sys_uretprobe",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 11, Patch: 0},
+	},
+	424: syscallinfo.Syscall{
+		Num:     424,
+		Name:    "pidfd_send_signal",
+		Entry:   "sys_pidfd_send_signal",
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
-				Sig:      "clockid_t which_clock",
-				Context:  0,
+				Sig:      "int pidfd",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
-				RefCount: 1,
-				Sig:      "struct compat_sigevent __user *timer_event_spec",
-				Context:  0,
+				RefCount: 0,
+				Sig:      "int sig",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
-				Sig:      "timer_t __user *created_timer_id",
-				Context:  0,
+				Sig:      "siginfo_t __user *info",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatSignal,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
 	},
-	527: syscallinfo.Syscall{
-		Num:     527,
-		Name:    "mq_notify",
-		Entry:   "compat_sys_mq_notify",
-		Context: 0,
+	425: syscallinfo.Syscall{
+		Num:     425,
+		Name:    "io_uring_setup",
+		Entry:   "sys_io_uring_setup",
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
-				Sig:      "mqd_t mqdes",
-				Context:  0,
+				Sig:      "u32 entries",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
-				Sig:      "const struct compat_sigevent __user *u_notification",
-				Context:  0,
+				Sig:      "struct io_uring_params __user *p",
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
 	},
-	528: syscallinfo.Syscall{
-		Num:     528,
-		Name:    "kexec_load",
-		Entry:   "compat_sys_kexec_load",
-		Context: 0,
+	426: syscallinfo.Syscall{
+		Num:     426,
+		Name:    "io_uring_enter",
+		Entry:   "sys_io_uring_enter",
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
-				Sig:      "compat_ulong_t entry",
-				Context:  0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "compat_ulong_t nr_segments",
-				Context:  0,
+				Sig:      "u32 to_submit",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "u32 min_complete",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "u32 flags",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
-				Sig:      "struct compat_kexec_segment __user *",
-				Context:  0,
+				Sig:      "const void __user *argp",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "compat_ulong_t flags",
-				Context:  0,
+				Sig:      "size_t argsz",
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
 	},
-	529: syscallinfo.Syscall{
-		Num:     529,
-		Name:    "waitid",
-		Entry:   "compat_sys_waitid",
-		Context: 0,
+	427: syscallinfo.Syscall{
+		Num:     427,
+		Name:    "io_uring_register",
+		Entry:   "sys_io_uring_register",
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
-				Sig:      "int",
-				Context:  0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "compat_pid_t",
-				Context:  0,
+				Sig:      "unsigned int op",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
-				Sig:      "struct compat_siginfo __user *",
-				Context:  0,
+				Sig:      "void __user *arg",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "int",
-				Context:  0,
-			},
-			{
-				RefCount: 1,
-				Sig:      "struct compat_rusage __user *",
-				Context:  0,
+				Sig:      "unsigned int nr_args",
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 1, Patch: 0},
 	},
-	530: syscallinfo.Syscall{
-		Num:     530,
-		Name:    "set_robust_list",
-		Entry:   "compat_sys_set_robust_list",
-		Context: 0,
+	428: syscallinfo.Syscall{
+		Num:     428,
+		Name:    "open_tree",
+		Entry:   "sys_open_tree",
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
-				RefCount: 1,
-				Sig:      "struct compat_robust_list_head __user *head",
-				Context:  0,
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 2, Patch: 0},
+	},
+	429: syscallinfo.Syscall{
+		Num:     429,
+		Name:    "move_mount",
+		Entry:   "sys_move_mount",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int from_dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *from_path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int to_dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *to_path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int ms_flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 2, Patch: 0},
+	},
+	430: syscallinfo.Syscall{
+		Num:     430,
+		Name:    "fsopen",
+		Entry:   "sys_fsopen",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *fs_name",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 2, Patch: 0},
+	},
+	431: syscallinfo.Syscall{
+		Num:     431,
+		Name:    "fsconfig",
+		Entry:   "sys_fsconfig",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fs_fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int cmd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *key",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const void __user *value",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int aux",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 2, Patch: 0},
+	},
+	432: syscallinfo.Syscall{
+		Num:     432,
+		Name:    "fsmount",
+		Entry:   "sys_fsmount",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fs_fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int ms_flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 2, Patch: 0},
+	},
+	433: syscallinfo.Syscall{
+		Num:     433,
+		Name:    "fspick",
+		Entry:   "sys_fspick",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 2, Patch: 0},
+	},
+	434: syscallinfo.Syscall{
+		Num:     434,
+		Name:    "pidfd_open",
+		Entry:   "sys_pidfd_open",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 3, Patch: 0},
+	},
+	435: syscallinfo.Syscall{
+		Num:     435,
+		Name:    "clone3",
+		Entry:   "sys_clone3",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct clone_args __user *uargs",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t size",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatProcess,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 3, Patch: 0},
+	},
+	436: syscallinfo.Syscall{
+		Num:     436,
+		Name:    "close_range",
+		Entry:   "sys_close_range",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int max_fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 9, Patch: 0},
+	},
+	437: syscallinfo.Syscall{
+		Num:     437,
+		Name:    "openat2",
+		Entry:   "sys_openat2",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct open_how __user *how",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t size",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 6, Patch: 0},
+	},
+	438: syscallinfo.Syscall{
+		Num:     438,
+		Name:    "pidfd_getfd",
+		Entry:   "sys_pidfd_getfd",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int pidfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 6, Patch: 0},
+	},
+	439: syscallinfo.Syscall{
+		Num:     439,
+		Name:    "faccessat2",
+		Entry:   "sys_faccessat2",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int mode",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 8, Patch: 0},
+	},
+	440: syscallinfo.Syscall{
+		Num:     440,
+		Name:    "process_madvise",
+		Entry:   "sys_process_madvise",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int pidfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct iovec __user *vec",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t vlen",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int behavior",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 10, Patch: 0},
+	},
+	441: syscallinfo.Syscall{
+		Num:     441,
+		Name:    "epoll_pwait2",
+		Entry:   "sys_epoll_pwait2",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int epfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct epoll_event __user *events",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int maxevents",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct __kernel_timespec __user *timeout",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const sigset_t __user *sigmask",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t sigsetsize",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 11, Patch: 0},
+	},
+	442: syscallinfo.Syscall{
+		Num:     442,
+		Name:    "mount_setattr",
+		Entry:   "sys_mount_setattr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct mount_attr __user *uattr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t usize",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 12, Patch: 0},
+	},
+	443: syscallinfo.Syscall{
+		Num:     443,
+		Name:    "quotactl_fd",
+		Entry:   "sys_quotactl_fd",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int cmd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "qid_t id",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "void __user *addr",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 14, Patch: 0},
+	},
+	444: syscallinfo.Syscall{
+		Num:     444,
+		Name:    "landlock_create_ruleset",
+		Entry:   "sys_landlock_create_ruleset",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const struct landlock_ruleset_attr __user *attr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t size",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "__u32 flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 13, Patch: 0},
+	},
+	445: syscallinfo.Syscall{
+		Num:     445,
+		Name:    "landlock_add_rule",
+		Entry:   "sys_landlock_add_rule",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int ruleset_fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "enum landlock_rule_type rule_type",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const void __user *rule_attr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "__u32 flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 13, Patch: 0},
+	},
+	446: syscallinfo.Syscall{
+		Num:     446,
+		Name:    "landlock_restrict_self",
+		Entry:   "sys_landlock_restrict_self",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int ruleset_fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "__u32 flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 13, Patch: 0},
+	},
+	447: syscallinfo.Syscall{
+		Num:     447,
+		Name:    "memfd_secret",
+		Entry:   "sys_memfd_secret",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc | syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 14, Patch: 0},
+	},
+	448: syscallinfo.Syscall{
+		Num:     448,
+		Name:    "process_mrelease",
+		Entry:   "sys_process_mrelease",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int pidfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 15, Patch: 0},
+	},
+	449: syscallinfo.Syscall{
+		Num:     449,
+		Name:    "futex_waitv",
+		Entry:   "sys_futex_waitv",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct futex_waitv __user *waiters",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int nr_futexes",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __kernel_timespec __user *timeout",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "clockid_t clockid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 16, Patch: 0},
+	},
+	450: syscallinfo.Syscall{
+		Num:     450,
+		Name:    "set_mempolicy_home_node",
+		Entry:   "sys_set_mempolicy_home_node",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long start",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long home_node",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 5, Minor: 17, Patch: 0},
+	},
+	451: syscallinfo.Syscall{
+		Num:     451,
+		Name:    "cachestat",
+		Entry:   "sys_cachestat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct cachestat_range __user *cstat_range",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct cachestat __user *cstat",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 5, Patch: 0},
+	},
+	452: syscallinfo.Syscall{
+		Num:     452,
+		Name:    "fchmodat2",
+		Entry:   "sys_fchmodat2",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "umode_t mode",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 6, Patch: 0},
+	},
+	453: syscallinfo.Syscall{
+		Num:     453,
+		Name:    "map_shadow_stack",
+		Entry:   "sys_map_shadow_stack",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long addr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long size",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 6, Patch: 0},
+	},
+	454: syscallinfo.Syscall{
+		Num:     454,
+		Name:    "futex_wake",
+		Entry:   "sys_futex_wake",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "void __user *uaddr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long mask",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int nr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 7, Patch: 0},
+	},
+	455: syscallinfo.Syscall{
+		Num:     455,
+		Name:    "futex_wait",
+		Entry:   "sys_futex_wait",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "void __user *uaddr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long val",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long mask",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __kernel_timespec __user *timeout",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "clockid_t clockid",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 7, Patch: 0},
+	},
+	456: syscallinfo.Syscall{
+		Num:     456,
+		Name:    "futex_requeue",
+		Entry:   "sys_futex_requeue",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct futex_waitv __user *waiters",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int nr_wake",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int nr_requeue",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 7, Patch: 0},
+	},
+	457: syscallinfo.Syscall{
+		Num:     457,
+		Name:    "statmount",
+		Entry:   "sys_statmount",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const struct mnt_id_req __user *req",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct statmount __user *buf",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t bufsize",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 8, Patch: 0},
+	},
+	458: syscallinfo.Syscall{
+		Num:     458,
+		Name:    "listmount",
+		Entry:   "sys_listmount",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const struct mnt_id_req __user *req",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "u64 __user *mnt_ids",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t nr_mnt_ids",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 8, Patch: 0},
+	},
+	459: syscallinfo.Syscall{
+		Num:     459,
+		Name:    "lsm_get_self_attr",
+		Entry:   "sys_lsm_get_self_attr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int attr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct lsm_ctx __user *ctx",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "u32 __user *size",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "u32 flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 8, Patch: 0},
+	},
+	460: syscallinfo.Syscall{
+		Num:     460,
+		Name:    "lsm_set_self_attr",
+		Entry:   "sys_lsm_set_self_attr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int attr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct lsm_ctx __user *ctx",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "u32 size",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "u32 flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 8, Patch: 0},
+	},
+	461: syscallinfo.Syscall{
+		Num:     461,
+		Name:    "lsm_list_modules",
+		Entry:   "sys_lsm_list_modules",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "u64 __user *ids",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "u32 __user *size",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "u32 flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 8, Patch: 0},
+	},
+	462: syscallinfo.Syscall{
+		Num:     462,
+		Name:    "mseal",
+		Entry:   "sys_mseal",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long start",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 10, Patch: 0},
+	},
+	463: syscallinfo.Syscall{
+		Num:     463,
+		Name:    "setxattrat",
+		Entry:   "sys_setxattrat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int at_flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct xattr_args __user *args",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t size",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 13, Patch: 0},
+	},
+	464: syscallinfo.Syscall{
+		Num:     464,
+		Name:    "getxattrat",
+		Entry:   "sys_getxattrat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int at_flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct xattr_args __user *args",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t size",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 13, Patch: 0},
+	},
+	465: syscallinfo.Syscall{
+		Num:     465,
+		Name:    "listxattrat",
+		Entry:   "sys_listxattrat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int at_flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "char __user *list",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t size",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 13, Patch: 0},
+	},
+	466: syscallinfo.Syscall{
+		Num:     466,
+		Name:    "removexattrat",
+		Entry:   "sys_removexattrat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int at_flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *name",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 13, Patch: 0},
+	},
+	467: syscallinfo.Syscall{
+		Num:     467,
+		Name:    "open_tree_attr",
+		Entry:   "sys_open_tree_attr",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *path",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct mount_attr __user *uattr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "size_t usize",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 6, Minor: 15, Patch: 0},
+	},
+	512: syscallinfo.Syscall{
+		Num:     512,
+		Name:    "rt_sigaction",
+		Entry:   "compat_sys_rt_sigaction",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct compat_sigaction __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct compat_sigaction __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "compat_size_t",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
+	},
+	513: syscallinfo.Syscall{
+		Num:        513,
+		Name:       "rt_sigreturn",
+		Entry:      "compat_sys_x32_rt_sigreturn",
+		Context:    syscallinfo.CtxNone,
+		Args:       []syscallinfo.Argument{},
+		Categories: syscallinfo.CatSignal,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
+		Status:     syscallinfo.SyscallUnknownSignature,
+	},
+	514: syscallinfo.Syscall{
+		Num:     514,
+		Name:    "ioctl",
+		Entry:   "compat_sys_ioctl",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int cmd",
+				Context:  syscallinfo.CtxIoctlReq,
+			},
+			{
+				RefCount: 0,
+				Sig:      "compat_ulong_t arg",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
+	},
+	515: syscallinfo.Syscall{
+		Num:     515,
+		Name:    "readv",
+		Entry:   "sys_readv",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct iovec __user *vec",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long vlen",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
+	},
+	516: syscallinfo.Syscall{
+		Num:     516,
+		Name:    "writev",
+		Entry:   "sys_writev",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct iovec __user *vec",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long vlen",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
+	},
+	517: syscallinfo.Syscall{
+		Num:     517,
+		Name:    "recvfrom",
+		Entry:   "compat_sys_recvfrom",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "void __user *buf",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "compat_size_t len",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned flags",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct sockaddr __user *addr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "int __user *addrlen",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
+	},
+	518: syscallinfo.Syscall{
+		Num:     518,
+		Name:    "sendmsg",
+		Entry:   "compat_sys_sendmsg",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct compat_msghdr __user *msg",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
+	},
+	519: syscallinfo.Syscall{
+		Num:     519,
+		Name:    "recvmsg",
+		Entry:   "compat_sys_recvmsg",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct compat_msghdr __user *msg",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
+	},
+	520: syscallinfo.Syscall{
+		Num:     520,
+		Name:    "execve",
+		Entry:   "compat_sys_execve",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const compat_uptr_t __user *argv",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const compat_uptr_t __user *envp",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatProcess,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
+	},
+	521: syscallinfo.Syscall{
+		Num:     521,
+		Name:    "ptrace",
+		Entry:   "compat_sys_ptrace",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "compat_long_t request",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "compat_long_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "compat_long_t addr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "compat_long_t data",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
+	},
+	522: syscallinfo.Syscall{
+		Num:     522,
+		Name:    "rt_sigpending",
+		Entry:   "compat_sys_rt_sigpending",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "compat_sigset_t __user *uset",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "compat_size_t sigsetsize",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
+	},
+	523: syscallinfo.Syscall{
+		Num:     523,
+		Name:    "rt_sigtimedwait",
+		Entry:   "compat_sys_rt_sigtimedwait_time64",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "compat_sigset_t __user *uthese",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct compat_siginfo __user *uinfo",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct __kernel_timespec __user *uts",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "compat_size_t sigsetsize",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
+	},
+	524: syscallinfo.Syscall{
+		Num:     524,
+		Name:    "rt_sigqueueinfo",
+		Entry:   "compat_sys_rt_sigqueueinfo",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "compat_pid_t pid",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int sig",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct compat_siginfo __user *uinfo",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
+	},
+	525: syscallinfo.Syscall{
+		Num:     525,
+		Name:    "sigaltstack",
+		Entry:   "compat_sys_sigaltstack",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "const compat_stack_t __user *uss_ptr",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "compat_stack_t __user *uoss_ptr",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatSignal,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
+	},
+	526: syscallinfo.Syscall{
+		Num:     526,
+		Name:    "timer_create",
+		Entry:   "compat_sys_timer_create",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "clockid_t which_clock",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct compat_sigevent __user *timer_event_spec",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "timer_t __user *created_timer_id",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
+	},
+	527: syscallinfo.Syscall{
+		Num:     527,
+		Name:    "mq_notify",
+		Entry:   "compat_sys_mq_notify",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "mqd_t mqdes",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct compat_sigevent __user *u_notification",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
+	},
+	528: syscallinfo.Syscall{
+		Num:     528,
+		Name:    "kexec_load",
+		Entry:   "compat_sys_kexec_load",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "compat_ulong_t entry",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "compat_ulong_t nr_segments",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct compat_kexec_segment __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "compat_ulong_t flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
+	},
+	529: syscallinfo.Syscall{
+		Num:     529,
+		Name:    "waitid",
+		Entry:   "compat_sys_waitid",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "compat_pid_t",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct compat_siginfo __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "struct compat_rusage __user *",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatProcess,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
+	},
+	530: syscallinfo.Syscall{
+		Num:     530,
+		Name:    "set_robust_list",
+		Entry:   "compat_sys_set_robust_list",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 1,
+				Sig:      "struct compat_robust_list_head __user *head",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "compat_size_t len",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
 	},
 	531: syscallinfo.Syscall{
 		Num:     531,
 		Name:    "get_robust_list",
 		Entry:   "compat_sys_get_robust_list",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "compat_uptr_t __user *head_ptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "compat_size_t __user *len_ptr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
 	},
 	532: syscallinfo.Syscall{
 		Num:     532,
 		Name:    "vmsplice",
-		Entry:   "compat_sys_vmsplice",
-		Context: 0,
+		Entry:   "sys_vmsplice",
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
-				Sig:      "const struct compat_iovec __user *",
-				Context:  0,
+				Sig:      "const struct iovec __user *iov",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "unsigned int nr_segs",
-				Context:  0,
+				Sig:      "unsigned long nr_segs",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
 	},
 	533: syscallinfo.Syscall{
 		Num:     533,
 		Name:    "move_pages",
-		Entry:   "compat_sys_move_pages",
-		Context: 0,
+		Entry:   "sys_move_pages",
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "compat_ulong_t nr_pages",
-				Context:  0,
+				Sig:      "unsigned long nr_pages",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
-				RefCount: 1,
-				Sig:      "__u32 __user *pages",
-				Context:  0,
+				RefCount: 2,
+				Sig:      "const void __user * __user *pages",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const int __user *nodes",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "int __user *status",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
 	},
 	534: syscallinfo.Syscall{
 		Num:     534,
 		Name:    "preadv",
 		Entry:   "compat_sys_preadv64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct compat_iovec __user *vec",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long vlen",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "loff_t pos",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
 	},
 	535: syscallinfo.Syscall{
 		Num:     535,
 		Name:    "pwritev",
 		Entry:   "compat_sys_pwritev64",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned long fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "const struct compat_iovec __user *vec",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned long vlen",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "loff_t pos",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
 	},
 	536: syscallinfo.Syscall{
 		Num:     536,
 		Name:    "rt_tgsigqueueinfo",
 		Entry:   "compat_sys_rt_tgsigqueueinfo",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "compat_pid_t tgid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "compat_pid_t pid",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int sig",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct compat_siginfo __user *uinfo",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatSignal,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
 	},
 	537: syscallinfo.Syscall{
 		Num:     537,
 		Name:    "recvmmsg",
-		Entry:   "compat_sys_recvmmsg",
-		Context: 0,
+		Entry:   "compat_sys_recvmmsg_time64",
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct compat_mmsghdr __user *mmsg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned vlen",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
-				Sig:      "struct compat_timespec __user *timeout",
-				Context:  0,
+				Sig:      "struct __kernel_timespec __user *timeout",
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
 	},
 	538: syscallinfo.Syscall{
 		Num:     538,
 		Name:    "sendmmsg",
 		Entry:   "compat_sys_sendmmsg",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "struct compat_mmsghdr __user *mmsg",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned vlen",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "unsigned int flags",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
 	},
 	539: syscallinfo.Syscall{
 		Num:     539,
 		Name:    "process_vm_readv",
-		Entry:   "compat_sys_process_vm_readv",
-		Context: 0,
+		Entry:   "sys_process_vm_readv",
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
-				Sig:      "compat_pid_t pid",
-				Context:  0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
-				Sig:      "const struct compat_iovec __user *lvec",
-				Context:  0,
+				Sig:      "const struct iovec __user *lvec",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "compat_ulong_t liovcnt",
-				Context:  0,
+				Sig:      "unsigned long liovcnt",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
-				Sig:      "const struct compat_iovec __user *rvec",
-				Context:  0,
+				Sig:      "const struct iovec __user *rvec",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "compat_ulong_t riovcnt",
-				Context:  0,
+				Sig:      "unsigned long riovcnt",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "compat_ulong_t flags",
-				Context:  0,
+				Sig:      "unsigned long flags",
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
 	},
 	540: syscallinfo.Syscall{
 		Num:     540,
 		Name:    "process_vm_writev",
-		Entry:   "compat_sys_process_vm_writev",
-		Context: 0,
+		Entry:   "sys_process_vm_writev",
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
-				Sig:      "compat_pid_t pid",
-				Context:  0,
+				Sig:      "pid_t pid",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
-				Sig:      "const struct compat_iovec __user *lvec",
-				Context:  0,
+				Sig:      "const struct iovec __user *lvec",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "compat_ulong_t liovcnt",
-				Context:  0,
+				Sig:      "unsigned long liovcnt",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
-				Sig:      "const struct compat_iovec __user *rvec",
-				Context:  0,
+				Sig:      "const struct iovec __user *rvec",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "compat_ulong_t riovcnt",
-				Context:  0,
+				Sig:      "unsigned long riovcnt",
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "compat_ulong_t flags",
-				Context:  0,
+				Sig:      "unsigned long flags",
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
 	},
 	541: syscallinfo.Syscall{
 		Num:     541,
 		Name:    "setsockopt",
-		Entry:   "compat_sys_setsockopt",
-		Context: 0,
+		Entry:   "sys_setsockopt",
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int level",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int optname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *optval",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
-				Sig:      "unsigned int optlen",
-				Context:  0,
+				Sig:      "int optlen",
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
 	},
 	542: syscallinfo.Syscall{
 		Num:     542,
 		Name:    "getsockopt",
-		Entry:   "compat_sys_getsockopt",
-		Context: 0,
+		Entry:   "sys_getsockopt",
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "int fd",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int level",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int optname",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "char __user *optval",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "int __user *optlen",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatNetwork,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
 	},
 	543: syscallinfo.Syscall{
 		Num:     543,
 		Name:    "io_setup",
 		Entry:   "compat_sys_io_setup",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "unsigned nr_reqs",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "u32 __user *ctx32p",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatMemory,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
 	},
 	544: syscallinfo.Syscall{
 		Num:     544,
 		Name:    "io_submit",
 		Entry:   "compat_sys_io_submit",
-		Context: 0,
+		Context: syscallinfo.CtxNone,
 		Args: []syscallinfo.Argument{
 			{
 				RefCount: 0,
 				Sig:      "compat_aio_context_t ctx_id",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 0,
 				Sig:      "int nr",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
 			},
 			{
 				RefCount: 1,
 				Sig:      "u32 __user *iocb",
-				Context:  0,
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: 0,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 4, Patch: 0},
+	},
+	545: syscallinfo.Syscall{
+		Num:     545,
+		Name:    "execveat",
+		Entry:   "compat_sys_execveat",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "int dfd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const char __user *filename",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const compat_uptr_t __user *argv",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const compat_uptr_t __user *envp",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "int flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatFile | syscallinfo.CatDesc | syscallinfo.CatProcess,
+		Since:      syscallinfo.KernelVersion{Major: 3, Minor: 19, Patch: 0},
+	},
+	546: syscallinfo.Syscall{
+		Num:     546,
+		Name:    "preadv2",
+		Entry:   "compat_sys_preadv64v2",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct iovec __user *vec",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long vlen",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "loff_t pos",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "rwf_t flags",
+				Context:  syscallinfo.CtxNone,
+			},
+		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 6, Patch: 0},
+	},
+	547: syscallinfo.Syscall{
+		Num:     547,
+		Name:    "pwritev2",
+		Entry:   "compat_sys_pwritev64v2",
+		Context: syscallinfo.CtxNone,
+		Args: []syscallinfo.Argument{
+			{
+				RefCount: 0,
+				Sig:      "unsigned long fd",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 1,
+				Sig:      "const struct iovec __user *vec",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "unsigned long vlen",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "loff_t pos",
+				Context:  syscallinfo.CtxNone,
+			},
+			{
+				RefCount: 0,
+				Sig:      "rwf_t flags",
+				Context:  syscallinfo.CtxNone,
 			},
 		},
+		Categories: syscallinfo.CatDesc,
+		Since:      syscallinfo.KernelVersion{Major: 4, Minor: 6, Patch: 0},
 	},
 }
@@ -0,0 +1,19 @@
+// MACHINE GENERATED BY 'go generate' COMMAND; DO NOT EDIT
+
+//go:build syscallinfo_embed
+// +build syscallinfo_embed
+
+package linux_amd64
+
+import (
+	_ "embed"
+
+	"github.com/jroimartin/syscallinfo"
+)
+
+//go:embed syscalltable_embed.bin
+var syscallTableData []byte
+
+// SyscallTable is the syscall table, decoded from the embedded data when the
+// package is initialized.
+var SyscallTable = syscallinfo.MustUnmarshalTable(syscallTableData)
@@ -0,0 +1,8 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linux_arm
+
+//go:generate go run $GOPATH/src/github.com/jroimartin/syscallinfo/mksyscalltable.go -output syscalltable.go -categories ../categories.json -versions ../versions.json linux_arm syscall.json
+//go:generate go run $GOPATH/src/github.com/jroimartin/syscallinfo/mksyscalltable.go -embed -output syscalltable_embed.go -categories ../categories.json -versions ../versions.json linux_arm syscall.json
@@ -0,0 +1,12 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linux_riscv64
+
+import "github.com/jroimartin/syscallinfo"
+
+func init() {
+	syscallinfo.Register("linux_riscv64", SyscallTable)
+	syscallinfo.RegisterSnapshot("linux_riscv64", "6.15", SyscallTable)
+}
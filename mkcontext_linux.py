@@ -30,24 +30,34 @@ def main():
     for line in tbl_file:
         syscall = {'num': -1, 'entry': '', 'name': '', 'context':'', 'args': []}
 
-        match = re.search(r'^(\w+)\t+\w+\t+(\w+)\t+(\w+)', line)
+        match = re.search(r'^(\w+)\t+\w+\t+(\w+)(?:\t+(\w+))?', line)
         if not match:
             continue
 
         num = match.group(1)
         name = match.group(2)
-        entrypoint = match.group(3)
+        entrypoint = match.group(3) or 'sys_ni_syscall'
 
         # Rename stub_* entrypoints to sys_*
         entrypoint = re.sub(r'^stub_', r'sys_', entrypoint)
 
-        if not tags.find(entry, entrypoint, ctags.TAG_FULLMATCH | ctags.TAG_OBSERVECASE):
-            continue
-
         syscall['num'] = int(num)
         syscall['name'] = name
         syscall['entry'] = entrypoint
 
+        # Record reserved numbers without implementation
+        if entrypoint == 'sys_ni_syscall':
+            syscall['status'] = 'NOT_IMPLEMENTED'
+            syscalls.append(syscall)
+            continue
+
+        # Record implemented syscalls whose prototype is not found (e.g.
+        # syscalls defined with SYSCALL_DEFINE in unusual places)
+        if not tags.find(entry, entrypoint, ctags.TAG_FULLMATCH | ctags.TAG_OBSERVECASE):
+            syscall['status'] = 'UNKNOWN_SIGNATURE'
+            syscalls.append(syscall)
+            continue
+
         found_prototype = False
         while not found_prototype:
             if(entry['kind'] == 'prototype'):
@@ -56,6 +66,8 @@ def main():
                 break
 
         if not found_prototype:
+            syscall['status'] = 'UNKNOWN_SIGNATURE'
+            syscalls.append(syscall)
             continue
 
         args = [];
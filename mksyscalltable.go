@@ -14,22 +14,36 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
+	"strings"
 	"text/template"
 
 	"github.com/jroimartin/syscallinfo"
 )
 
-var filename = flag.String("output", "", "output file name (standard output if omitted)")
+var (
+	filename = flag.String("output", "", "output file name (standard output if omitted)")
+	catfile  = flag.String("categories", "", "category annotation file")
+	verfile  = flag.String("versions", "", "kernel version annotation file")
+	varname  = flag.String("var", "SyscallTable", "name of the generated table variable")
+	difffile = flag.String("diff", "", "emit the differences between this ctxfile and the provided one instead of the table")
+	embed    = flag.Bool("embed", false, "embed the table as binary data decoded at init (requires -output)")
+)
 
 type SyscallinfoPackage struct {
 	PkgName  string
+	VarName  string
 	Syscalls []syscallinfo.Syscall
+
+	// Embed mode only.
+	DataFile string
+	DataVar  string
 }
 
 func main() {
 	flag.Usage = usage
 	flag.Parse()
-	if len(flag.Args()) != 2 {
+	if len(flag.Args()) != 2 || (*embed && *filename == "") {
 		usage()
 	}
 	pkgname := flag.Arg(0)
@@ -40,15 +54,76 @@ func main() {
 		log.Fatalln(err)
 	}
 
-	sipkg := SyscallinfoPackage{PkgName: pkgname}
+	sipkg := SyscallinfoPackage{PkgName: pkgname, VarName: *varname}
 	if err := json.Unmarshal(ctxdata, &sipkg.Syscalls); err != nil {
 		log.Fatalln(err)
 	}
 
+	if *catfile != "" {
+		catdata, err := ioutil.ReadFile(*catfile)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		ann, err := syscallinfo.ParseCategoryAnnotation(catdata)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		for i, sc := range sipkg.Syscalls {
+			sipkg.Syscalls[i].Categories = ann.Categories(sc.Name)
+		}
+	}
+
+	if *verfile != "" {
+		verdata, err := ioutil.ReadFile(*verfile)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		ann, err := syscallinfo.ParseVersionAnnotation(verdata)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		for i, sc := range sipkg.Syscalls {
+			since, until, err := ann.Versions(pkgname, sc)
+			if err != nil {
+				log.Fatalln(err)
+			}
+			sipkg.Syscalls[i].Since = since
+			sipkg.Syscalls[i].Until = until
+		}
+	}
+
 	var buf bytes.Buffer
-	t := template.Must(template.New("src").Parse(srcTemplate))
-	if err := t.Execute(&buf, sipkg); err != nil {
-		log.Fatalln(err)
+	if *difffile != "" {
+		olddata, err := ioutil.ReadFile(*difffile)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		var oldSyscalls []syscallinfo.Syscall
+		if err := json.Unmarshal(olddata, &oldSyscalls); err != nil {
+			log.Fatalln(err)
+		}
+		d := syscallinfo.DiffTables(newTable(oldSyscalls), newTable(sipkg.Syscalls))
+		buf.WriteString(d.String())
+	} else if *embed {
+		data, err := newTable(sipkg.Syscalls).MarshalBinary()
+		if err != nil {
+			log.Fatalln(err)
+		}
+		datafile := strings.TrimSuffix(*filename, ".go") + ".bin"
+		if err := ioutil.WriteFile(datafile, data, 0644); err != nil {
+			log.Fatalln(err)
+		}
+		sipkg.DataFile = filepath.Base(datafile)
+		sipkg.DataVar = strings.ToLower(sipkg.VarName[:1]) + sipkg.VarName[1:] + "Data"
+		t := template.Must(template.New("embed").Parse(embedTemplate))
+		if err := t.Execute(&buf, sipkg); err != nil {
+			log.Fatalln(err)
+		}
+	} else {
+		t := template.Must(template.New("src").Parse(srcTemplate))
+		if err := t.Execute(&buf, sipkg); err != nil {
+			log.Fatalln(err)
+		}
 	}
 	if *filename != "" {
 		err = ioutil.WriteFile(*filename, buf.Bytes(), 0644)
@@ -60,6 +135,14 @@ func main() {
 	}
 }
 
+func newTable(syscalls []syscallinfo.Syscall) syscallinfo.SyscallTable {
+	tbl := syscallinfo.SyscallTable{}
+	for _, sc := range syscalls {
+		tbl[sc.Num] = sc
+	}
+	return tbl
+}
+
 func usage() {
 	fmt.Fprintln(os.Stderr, "usage: go run mksyscalltable.go [flags] pkgname ctxfile")
 	flag.PrintDefaults()
@@ -68,23 +151,51 @@ func usage() {
 
 const srcTemplate = `// MACHINE GENERATED BY 'go generate' COMMAND; DO NOT EDIT
 
+//go:build !syscallinfo_embed
+// +build !syscallinfo_embed
+
 package {{.PkgName}}
 
 import "github.com/jroimartin/syscallinfo"
 
-var SyscallTable = syscallinfo.SyscallTable{
+var {{.VarName}} = syscallinfo.SyscallTable{
 {{range .Syscalls}}	{{.Num}}: syscallinfo.Syscall{
 		Num: {{.Num}},
 		Name: "{{.Name}}",
 		Entry: "{{.Entry}}",
-		Context: {{.Context}},
+		Context: {{printf "%#v" .Context}},
 		Args: []syscallinfo.Argument{
 {{range .Args}}			{
 				RefCount: {{.RefCount}},
 				Sig: "{{.Sig}}",
-				Context: {{.Context}},
+				Context: {{printf "%#v" .Context}},
 			},
 {{end}}		},
-	},
+		Categories: {{printf "%#v" .Categories}},
+{{if not .Since.IsZero}}		Since: {{printf "%#v" .Since}},
+{{end}}{{if not .Until.IsZero}}		Until: {{printf "%#v" .Until}},
+{{end}}{{if .Status}}		Status: {{printf "%#v" .Status}},
+{{end}}	},
 {{end}}}
 `
+
+const embedTemplate = `// MACHINE GENERATED BY 'go generate' COMMAND; DO NOT EDIT
+
+//go:build syscallinfo_embed
+// +build syscallinfo_embed
+
+package {{.PkgName}}
+
+import (
+	_ "embed"
+
+	"github.com/jroimartin/syscallinfo"
+)
+
+//go:embed {{.DataFile}}
+var {{.DataVar}} []byte
+
+// {{.VarName}} is the syscall table, decoded from the embedded data when the
+// package is initialized.
+var {{.VarName}} = syscallinfo.MustUnmarshalTable({{.DataVar}})
+`
@@ -0,0 +1,204 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// Some architectures (e.g. linux_386) multiplex several syscalls through a
+// single entry point, which receives the number of the sub-call as its first
+// argument. multiplexers links the name of each multiplexer syscall with the
+// syscalls it multiplexes, indexed by sub-call number.
+var multiplexers = map[string]map[int]Syscall{
+	"socketcall": socketcallSyscalls,
+	"ipc":        ipcSyscalls,
+}
+
+// subcall returns the multiplexer and the sub-call number that correspond to
+// the syscall with the given name.
+func subcall(name string) (mux string, call int, ok bool) {
+	for mux, calls := range multiplexers {
+		for call, sc := range calls {
+			if sc.Name == name {
+				return mux, call, true
+			}
+		}
+	}
+	return "", 0, false
+}
+
+// subSyscall returns a Syscall object that describes a multiplexed syscall.
+// Its number is set to the one of the multiplexer when it is demultiplexed.
+func subSyscall(name string, cat Category, ctx Context, sigs ...string) Syscall {
+	return Syscall{
+		Name:       name,
+		Entry:      "sys_" + name,
+		Context:    ctx,
+		Args:       newArgs(sigs...),
+		Categories: cat,
+	}
+}
+
+// socketcallSyscalls contains the syscalls multiplexed by socketcall. See
+// include/uapi/linux/net.h.
+var socketcallSyscalls = map[int]Syscall{
+	1:  subSyscall("socket", CatNetwork, CtxFD, "int family", "int type", "int protocol"),
+	2:  subSyscall("bind", CatNetwork, CtxNone, "int fd", "struct sockaddr __user *umyaddr", "int addrlen"),
+	3:  subSyscall("connect", CatNetwork, CtxNone, "int fd", "struct sockaddr __user *uservaddr", "int addrlen"),
+	4:  subSyscall("listen", CatNetwork, CtxNone, "int fd", "int backlog"),
+	5:  subSyscall("accept", CatNetwork, CtxFD, "int fd", "struct sockaddr __user *upeer_sockaddr", "int __user *upeer_addrlen"),
+	6:  subSyscall("getsockname", CatNetwork, CtxNone, "int fd", "struct sockaddr __user *usockaddr", "int __user *usockaddr_len"),
+	7:  subSyscall("getpeername", CatNetwork, CtxNone, "int fd", "struct sockaddr __user *usockaddr", "int __user *usockaddr_len"),
+	8:  subSyscall("socketpair", CatNetwork, CtxNone, "int family", "int type", "int protocol", "int __user *usockvec"),
+	9:  subSyscall("send", CatNetwork, CtxNone, "int fd", "void __user *buff", "size_t len", "unsigned int flags"),
+	10: subSyscall("recv", CatNetwork, CtxNone, "int fd", "void __user *ubuf", "size_t size", "unsigned int flags"),
+	11: subSyscall("sendto", CatNetwork, CtxNone, "int fd", "void __user *buff", "size_t len", "unsigned int flags", "struct sockaddr __user *addr", "int addr_len"),
+	12: subSyscall("recvfrom", CatNetwork, CtxNone, "int fd", "void __user *ubuf", "size_t size", "unsigned int flags", "struct sockaddr __user *addr", "int __user *addr_len"),
+	13: subSyscall("shutdown", CatNetwork, CtxNone, "int fd", "int how"),
+	14: subSyscall("setsockopt", CatNetwork, CtxNone, "int fd", "int level", "int optname", "char __user *optval", "int optlen"),
+	15: subSyscall("getsockopt", CatNetwork, CtxNone, "int fd", "int level", "int optname", "char __user *optval", "int __user *optlen"),
+	16: subSyscall("sendmsg", CatNetwork, CtxNone, "int fd", "struct user_msghdr __user *msg", "unsigned int flags"),
+	17: subSyscall("recvmsg", CatNetwork, CtxNone, "int fd", "struct user_msghdr __user *msg", "unsigned int flags"),
+	18: subSyscall("accept4", CatNetwork, CtxFD, "int fd", "struct sockaddr __user *upeer_sockaddr", "int __user *upeer_addrlen", "int flags"),
+	19: subSyscall("recvmmsg", CatNetwork, CtxNone, "int fd", "struct mmsghdr __user *mmsg", "unsigned int vlen", "unsigned int flags", "struct timespec __user *timeout"),
+	20: subSyscall("sendmmsg", CatNetwork, CtxNone, "int fd", "struct mmsghdr __user *mmsg", "unsigned int vlen", "unsigned int flags"),
+}
+
+// ipcSyscalls contains the syscalls multiplexed by ipc. See
+// include/uapi/linux/ipc.h.
+var ipcSyscalls = map[int]Syscall{
+	1:  subSyscall("semop", CatIPC, CtxNone, "int semid", "struct sembuf __user *tsops", "unsigned nsops"),
+	2:  subSyscall("semget", CatIPC, CtxNone, "key_t key", "int nsems", "int semflg"),
+	3:  subSyscall("semctl", CatIPC, CtxNone, "int semid", "int semnum", "int cmd", "unsigned long arg"),
+	4:  subSyscall("semtimedop", CatIPC, CtxNone, "int semid", "struct sembuf __user *tsops", "unsigned nsops", "const struct timespec __user *timeout"),
+	11: subSyscall("msgsnd", CatIPC, CtxNone, "int msqid", "struct msgbuf __user *msgp", "size_t msgsz", "int msgflg"),
+	12: subSyscall("msgrcv", CatIPC, CtxNone, "int msqid", "struct msgbuf __user *msgp", "size_t msgsz", "long msgtyp", "int msgflg"),
+	13: subSyscall("msgget", CatIPC, CtxNone, "key_t key", "int msgflg"),
+	14: subSyscall("msgctl", CatIPC, CtxNone, "int msqid", "int cmd", "struct msqid_ds __user *buf"),
+	21: subSyscall("shmat", CatIPC|CatMemory, CtxNone, "int shmid", "char __user *shmaddr", "int shmflg"),
+	22: subSyscall("shmdt", CatIPC|CatMemory, CtxNone, "char __user *shmaddr"),
+	23: subSyscall("shmget", CatIPC, CtxNone, "key_t key", "size_t size", "int shmflg"),
+	24: subSyscall("shmctl", CatIPC, CtxNone, "int shmid", "int cmd", "struct shmid_ds __user *buf"),
+}
+
+// wordSize is the size of the words read from memory by Demultiplex. It
+// matches the size of unsigned long in linux_386.
+const wordSize = 4
+
+// Demultiplex returns the call of the syscall multiplexed by scc, which must
+// be a call to socketcall or ipc. mem gives access to the memory of the
+// traced process and is used to read the arguments that are passed by
+// reference (e.g. the array of arguments of socketcall). Words are read as
+// 32-bit little endian integers, as used by linux_386. If scc has fewer
+// arguments than the multiplexer (e.g. a call read from an audit log, which
+// only logs four), it returns an ArgCountError.
+func Demultiplex(scc *SyscallCall, mem io.ReaderAt) (*SyscallCall, error) {
+	switch scc.sc.Name {
+	case "socketcall":
+		return demuxSocketcall(scc, mem)
+	case "ipc":
+		return demuxIPC(scc, mem)
+	}
+	return nil, &NotMultiplexedError{Syscall: scc.sc.Name}
+}
+
+func demuxSocketcall(scc *SyscallCall, mem io.ReaderAt) (*SyscallCall, error) {
+	if len(scc.args) < 2 {
+		return nil, &ArgCountError{Want: 2, Got: len(scc.args)}
+	}
+	call := int(scc.args[0])
+	sc, ok := socketcallSyscalls[call]
+	if !ok {
+		return nil, &UnknownSubcallError{Syscall: "socketcall", Call: call}
+	}
+	args, err := readWords(mem, scc.args[1], len(sc.Args))
+	if err != nil {
+		return nil, err
+	}
+	return newSubCall(scc, sc, args...), nil
+}
+
+func demuxIPC(scc *SyscallCall, mem io.ReaderAt) (*SyscallCall, error) {
+	if len(scc.args) < 6 {
+		return nil, &ArgCountError{Want: 6, Got: len(scc.args)}
+	}
+	call := int(scc.args[0] & 0xffff)
+	version := scc.args[0] >> 16
+	first, second, third, ptr, fifth := scc.args[1], scc.args[2], scc.args[3], scc.args[4], scc.args[5]
+
+	sc, ok := ipcSyscalls[call]
+	if !ok {
+		return nil, &UnknownSubcallError{Syscall: "ipc", Call: call}
+	}
+	switch sc.Name {
+	case "semop":
+		return newSubCall(scc, sc, first, ptr, second), nil
+	case "semtimedop":
+		return newSubCall(scc, sc, first, ptr, second, fifth), nil
+	case "semget", "shmget":
+		return newSubCall(scc, sc, first, second, third), nil
+	case "semctl":
+		arg, err := readWords(mem, ptr, 1)
+		if err != nil {
+			return nil, err
+		}
+		return newSubCall(scc, sc, first, second, third, arg[0]), nil
+	case "msgsnd":
+		return newSubCall(scc, sc, first, ptr, second, third), nil
+	case "msgrcv":
+		if version != 0 {
+			return newSubCall(scc, sc, first, ptr, second, fifth, third), nil
+		}
+		// struct ipc_kludge {msgp, msgtyp}
+		kludge, err := readWords(mem, ptr, 2)
+		if err != nil {
+			return nil, err
+		}
+		return newSubCall(scc, sc, first, kludge[0], second, kludge[1], third), nil
+	case "msgget":
+		return newSubCall(scc, sc, first, second), nil
+	case "msgctl", "shmctl":
+		return newSubCall(scc, sc, first, second, ptr), nil
+	case "shmat":
+		if version != 0 {
+			return nil, &UnsupportedVersionError{Syscall: "ipc", Subcall: sc.Name, Version: int(version)}
+		}
+		return newSubCall(scc, sc, first, ptr, second), nil
+	case "shmdt":
+		return newSubCall(scc, sc, ptr), nil
+	}
+	return nil, &UnknownSubcallError{Syscall: "ipc", Call: call}
+}
+
+// newSubCall returns a call to sc that inherits the number, return value,
+// word size and context handler of the multiplexer call scc.
+func newSubCall(scc *SyscallCall, sc Syscall, args ...uint64) *SyscallCall {
+	sc.Num = scc.sc.Num
+	return &SyscallCall{
+		sc:       sc,
+		ret:      scc.ret,
+		args:     args,
+		ch:       scc.ch,
+		wordSize: scc.wordSize,
+	}
+}
+
+// readWords reads n words from mem at addr.
+func readWords(mem io.ReaderAt, addr uint64, n int) ([]uint64, error) {
+	if mem == nil {
+		return nil, ErrMemoryRequired
+	}
+	buf := make([]byte, n*wordSize)
+	if _, err := mem.ReadAt(buf, int64(addr)); err != nil {
+		return nil, err
+	}
+	words := make([]uint64, n)
+	for i := range words {
+		words[i] = uint64(binary.LittleEndian.Uint32(buf[i*wordSize:]))
+	}
+	return words, nil
+}
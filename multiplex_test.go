@@ -0,0 +1,145 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/jroimartin/syscallinfo"
+	"github.com/jroimartin/syscallinfo/linux_386"
+)
+
+// newMem returns a memory reader which contains the provided 32-bit words
+// starting at addr.
+func newMem(addr int, words ...uint32) *bytes.Reader {
+	buf := make([]byte, addr+4*len(words))
+	for i, w := range words {
+		binary.LittleEndian.PutUint32(buf[addr+4*i:], w)
+	}
+	return bytes.NewReader(buf)
+}
+
+var checksDemultiplex = []struct {
+	num      int
+	args     []uint64
+	ret      uint64
+	mem      []uint32
+	output   string
+	nilError bool
+}{
+	{
+		102,
+		[]uint64{3, 0x100},
+		0,
+		[]uint32{3, 0x2000, 16},
+		"connect(3, 0x00002000, 0x00000010) = 0x00000000",
+		true,
+	},
+	{
+		102,
+		[]uint64{1, 0x100},
+		5,
+		[]uint32{2, 1, 0},
+		"socket(0x00000002, 0x00000001, 0x00000000) = 5",
+		true,
+	},
+	{
+		117,
+		[]uint64{1, 7, 2, 0, 0x3000, 0},
+		0,
+		nil,
+		"semop(0x00000007, 0x00003000, 0x00000002) = 0x00000000",
+		true,
+	},
+	{
+		117,
+		[]uint64{21, 7, 0, 0x4000, 0x5000, 0},
+		0,
+		nil,
+		"shmat(0x00000007, 0x00005000, 0x00000000) = 0x00000000",
+		true,
+	},
+	{
+		117,
+		[]uint64{12, 7, 128, 0, 0x100, 0},
+		0,
+		[]uint32{0x6000, 2},
+		"msgrcv(0x00000007, 0x00006000, 0x00000080, 0x00000002, 0x00000000) = 0x00000000",
+		true,
+	},
+	{
+		102,
+		[]uint64{42, 0x100},
+		0,
+		nil,
+		"",
+		false,
+	},
+	{
+		3,
+		[]uint64{1, 2, 3},
+		0,
+		nil,
+		"",
+		false,
+	},
+}
+
+func TestDemultiplex(t *testing.T) {
+	r := syscallinfo.NewResolver(linux_386.SyscallTable)
+	for _, check := range checksDemultiplex {
+		sc, err := r.SyscallN(check.num)
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		scc, err := syscallinfo.NewSyscallCall(sc, check.ret, check.args...)
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		subscc, err := syscallinfo.Demultiplex(scc, newMem(0x100, check.mem...))
+		if err != nil {
+			if check.nilError {
+				t.Errorf("wrong error (want=nil, get=%v)", err)
+			}
+			continue
+		}
+		if !check.nilError {
+			t.Errorf("wrong error (want=non-nil, get=nil) for %v", check.args)
+			continue
+		}
+		if subscc.Syscall().Num != check.num {
+			t.Errorf("wrong number (want=%v, get=%v)", check.num, subscc.Syscall().Num)
+		}
+		if str := subscc.String(); str != check.output {
+			t.Errorf("wrong string (want=%v, get=%v)", check.output, str)
+		}
+	}
+}
+
+var checksDemultiplexArgCount = []struct {
+	name string
+	args []uint64
+	want int
+}{
+	{"socketcall", []uint64{3}, 2},
+	{"ipc", []uint64{1, 2, 3, 0x100}, 6},
+}
+
+func TestDemultiplex_argCount(t *testing.T) {
+	for _, check := range checksDemultiplexArgCount {
+		sc := syscallinfo.Syscall{Name: check.name}
+		scc, err := syscallinfo.NewSyscallCall(sc, 0, check.args...)
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		_, err = syscallinfo.Demultiplex(scc, newMem(0x100, 0, 0, 0))
+		aerr, ok := err.(*syscallinfo.ArgCountError)
+		if !ok || aerr.Want != check.want || aerr.Got != len(check.args) {
+			t.Errorf("%v: wrong error (want=ArgCountError %v, get=%v)", check.name, check.want, err)
+		}
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// qemuArches links the targets of the QEMU user-mode emulators (the suffix
+// of qemu-<target>) with the names of the archs.
+var qemuArches = map[string]string{
+	"x86_64":  "linux_amd64",
+	"i386":    "linux_386",
+	"arm":     "linux_arm",
+	"aarch64": "linux_arm64",
+	"riscv64": "linux_riscv64",
+}
+
+// ArchFromQEMU returns the name of the arch emulated by qemu-<target> (e.g.
+// "linux_arm64" for "aarch64").
+func ArchFromQEMU(target string) (string, error) {
+	name, ok := qemuArches[strings.TrimPrefix(target, "qemu-")]
+	if !ok {
+		return "", &UnknownArchError{Arch: "qemu target " + target}
+	}
+	return name, nil
+}
+
+var (
+	qemuPIDRE     = regexp.MustCompile(`^(\d+)\s+`)
+	qemuCallRE    = regexp.MustCompile(`^([a-z_][a-z0-9_]*)\((.*)$`)
+	qemuUnknownRE = regexp.MustCompile(`^Unknown syscall (\d+)$`)
+	qemuRetRE     = regexp.MustCompile(`^=\s*(-?\w+)(?:\s+(.*))?$`)
+	qemuKilledRE  = regexp.MustCompile(`^qemu: uncaught target signal (\d+) \([^)]*\)(?: - (core dumped))?$`)
+)
+
+// A QEMUReader reads the records of the log printed by the QEMU user-mode
+// emulators (qemu-<target>) when the -strace option is used. Calls are
+// resolved by name against the table of the emulated arch, so the reader
+// must be created with a resolver for that arch (see ArchFromQEMU).
+//
+// QEMU prints the return value of a call after executing it, so the output
+// of the emulated program may appear between a call and its return value.
+// Such lines are ignored while a call is waiting for its return value.
+// Calls that never return (e.g. exit_group) are returned with the status
+// StatusUnfinished when the process makes another call or at the end of
+// the log.
+//
+// Failed calls are returned with the value printed by QEMU (-1) and the
+// errno in Info (e.g. "errno=2 (No such file or directory)").
+type QEMUReader struct {
+	r       Resolver
+	s       *bufio.Scanner
+	line    int
+	pending map[int]*straceCall
+	last    int
+	queue   []*TraceRecord
+}
+
+// NewQEMUReader returns a QEMUReader that reads from rd and resolves
+// syscall names using r.
+func NewQEMUReader(rd io.Reader, r Resolver) *QEMUReader {
+	return &QEMUReader{
+		r:       r,
+		s:       bufio.NewScanner(rd),
+		pending: map[int]*straceCall{},
+		last:    -1,
+	}
+}
+
+// Read returns the next record of the log. It returns io.EOF when there are
+// no more records. Lines that cannot be parsed are reported as
+// TraceSyntaxError.
+func (qr *QEMUReader) Read() (*TraceRecord, error) {
+	for len(qr.queue) == 0 {
+		if !qr.s.Scan() {
+			if err := qr.s.Err(); err != nil {
+				return nil, err
+			}
+			if len(qr.pending) == 0 {
+				return nil, io.EOF
+			}
+			qr.flush(-1)
+			break
+		}
+		qr.line++
+		if err := qr.parseLine(qr.s.Text()); err != nil {
+			return nil, err
+		}
+	}
+	rec := qr.queue[0]
+	qr.queue = qr.queue[1:]
+	return rec, nil
+}
+
+// ReadAll reads all the remaining records of the log.
+func (qr *QEMUReader) ReadAll() ([]*TraceRecord, error) {
+	return ReadAllRecords(qr)
+}
+
+func (qr *QEMUReader) parseLine(line string) error {
+	text := strings.TrimSpace(line)
+	if text == "" {
+		return nil
+	}
+
+	// The return value of the last call, printed after the output of the
+	// program.
+	if call, ok := qr.pending[qr.last]; ok && strings.HasPrefix(text, "=") {
+		delete(qr.pending, qr.last)
+		call.rec.Line = qr.line
+		if !qr.complete(call.rec, call.name, call.args+" "+text) {
+			return qr.syntaxError(line)
+		}
+		qr.queue = append(qr.queue, call.rec)
+		return nil
+	}
+
+	rec := &TraceRecord{Line: qr.line, wordSize: qr.r.wordSize()}
+	if m := qemuPIDRE.FindStringSubmatch(text); m != nil {
+		rec.PID, _ = strconv.Atoi(m[1])
+		text = text[len(m[0]):]
+	}
+
+	if m := straceSignalRE.FindStringSubmatch(text); m != nil {
+		sig, err := ParseSignal(m[1])
+		if err != nil {
+			return qr.syntaxError(line)
+		}
+		rec.Kind = TraceSignal
+		rec.Signal = sig
+		rec.Info = m[2]
+		qr.queue = append(qr.queue, rec)
+		return nil
+	}
+	if m := qemuKilledRE.FindStringSubmatch(text); m != nil {
+		rec.Kind = TraceKilled
+		rec.Signal, _ = strconv.Atoi(m[1])
+		rec.Info = m[2]
+		qr.flush(-1)
+		qr.queue = append(qr.queue, rec)
+		return nil
+	}
+	if m := qemuUnknownRE.FindStringSubmatch(text); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		rec.Kind = TraceSyscall
+		rec.Syscall = qr.r.SyscallNOrUnknown(n)
+		rec.Status = StatusUnavailable
+		qr.flush(rec.PID)
+		qr.queue = append(qr.queue, rec)
+		return nil
+	}
+	if m := qemuCallRE.FindStringSubmatch(text); m != nil {
+		name, rest := m[1], m[2]
+		qr.flush(rec.PID)
+		if _, tail, ok := splitStraceArgs(rest); ok && !strings.HasPrefix(strings.TrimSpace(tail), "=") {
+			// The call has not returned yet. Its tail is the output of
+			// the program, if any.
+			qr.pending[rec.PID] = &straceCall{rec: rec, name: name, args: rest[:len(rest)-len(tail)]}
+			qr.last = rec.PID
+			return nil
+		}
+		if !qr.complete(rec, name, rest) {
+			return qr.syntaxError(line)
+		}
+		qr.queue = append(qr.queue, rec)
+		return nil
+	}
+	if len(qr.pending) > 0 {
+		// Output of the program.
+		return nil
+	}
+	return qr.syntaxError(line)
+}
+
+// complete fills rec with the call to the syscall name. rest is the text
+// that follows the opening parenthesis, which includes the arguments and
+// the return value. It returns false if rest cannot be parsed.
+func (qr *QEMUReader) complete(rec *TraceRecord, name, rest string) bool {
+	args, tail, ok := splitStraceArgs(rest)
+	if !ok {
+		return false
+	}
+	qr.setCall(rec, name, args)
+	m := qemuRetRE.FindStringSubmatch(strings.TrimSpace(tail))
+	if m == nil {
+		return false
+	}
+	rec.Ret = parseTraceValue(m[1])
+	rec.Info = m[2]
+	rec.Status = StatusSuccessful
+	if strings.HasPrefix(rec.Info, "errno=") {
+		rec.Status = StatusFailed
+	} else {
+		rec.Info = strings.TrimSuffix(strings.TrimPrefix(rec.Info, "("), ")")
+	}
+	return true
+}
+
+// setCall sets the syscall and arguments of rec.
+func (qr *QEMUReader) setCall(rec *TraceRecord, name string, args []string) {
+	rec.Kind = TraceSyscall
+	rec.Syscall = resolveName(qr.r, name)
+	rec.Args = make([]TraceValue, len(args))
+	for i, arg := range args {
+		rec.Args[i] = parseTraceValue(arg)
+	}
+}
+
+// flush queues the pending call of the process pid as a call that did not
+// return. If pid is -1, the pending calls of all the processes are queued,
+// sorted by PID.
+func (qr *QEMUReader) flush(pid int) {
+	var pids []int
+	for p := range qr.pending {
+		if pid == -1 || p == pid {
+			pids = append(pids, p)
+		}
+	}
+	sort.Ints(pids)
+	for _, p := range pids {
+		call := qr.pending[p]
+		delete(qr.pending, p)
+		args, _, _ := splitStraceArgs(call.args)
+		qr.setCall(call.rec, call.name, args)
+		call.rec.Status = StatusUnfinished
+		qr.queue = append(qr.queue, call.rec)
+	}
+}
+
+func (qr *QEMUReader) syntaxError(line string) error {
+	return &TraceSyntaxError{Format: "qemu", Line: qr.line, Text: line}
+}
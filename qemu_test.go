@@ -0,0 +1,175 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jroimartin/syscallinfo"
+	"github.com/jroimartin/syscallinfo/linux_386"
+	_ "github.com/jroimartin/syscallinfo/linux_arm"
+	_ "github.com/jroimartin/syscallinfo/linux_arm64"
+	_ "github.com/jroimartin/syscallinfo/linux_riscv64"
+)
+
+const qemuLog = `4321 brk(NULL) = 0x0804c000
+4321 openat(AT_FDCWD,"/etc/ld.so.cache",O_RDONLY|O_CLOEXEC) = 3
+4321 access("/etc/ld.so.preload",R_OK) = -1 errno=2 (No such file or directory)
+4321 mmap2(NULL,8192,PROT_READ|PROT_WRITE,MAP_PRIVATE|MAP_ANONYMOUS,-1,0) = 0xf7fd6000
+4321 write(1,0x804a008,12)hello, world
+ = 12
+4321 Unknown syscall 999
+--- SIGSEGV {si_signo=SIGSEGV, si_code=1, si_addr=0x00000000} ---
+4321 exit_group(0)
+`
+
+var checksQEMU = []struct {
+	kind   syscallinfo.TraceKind
+	line   int
+	name   string
+	args   []uint64
+	ret    uint64
+	status syscallinfo.CallStatus
+	info   string
+}{
+	{syscallinfo.TraceSyscall, 1, "brk", []uint64{0}, 0x0804c000, syscallinfo.StatusSuccessful, ""},
+	{syscallinfo.TraceSyscall, 2, "openat", []uint64{^uint64(99), 0, 0x80000}, 3, syscallinfo.StatusSuccessful, ""},
+	{syscallinfo.TraceSyscall, 3, "access", []uint64{0, 0}, ^uint64(0), syscallinfo.StatusFailed, "errno=2 (No such file or directory)"},
+	{syscallinfo.TraceSyscall, 4, "mmap2", []uint64{0, 8192, 0, 0, ^uint64(0), 0}, 0xf7fd6000, syscallinfo.StatusSuccessful, ""},
+	{syscallinfo.TraceSyscall, 6, "write", []uint64{1, 0x804a008, 12}, 12, syscallinfo.StatusSuccessful, ""},
+	{syscallinfo.TraceSyscall, 7, "syscall_0x3e7", nil, 0, syscallinfo.StatusUnavailable, ""},
+	{syscallinfo.TraceSignal, 8, "", nil, 0, 0, "{si_signo=SIGSEGV, si_code=1, si_addr=0x00000000}"},
+	{syscallinfo.TraceSyscall, 9, "exit_group", []uint64{0}, 0, syscallinfo.StatusUnfinished, ""},
+}
+
+func TestQEMUReader(t *testing.T) {
+	r := syscallinfo.NewResolver(linux_386.SyscallTable)
+	recs, err := syscallinfo.NewQEMUReader(strings.NewReader(qemuLog), r).ReadAll()
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	if len(recs) != len(checksQEMU) {
+		t.Fatalf("wrong number of records (want=%v, get=%v)", len(checksQEMU), len(recs))
+	}
+	for i, check := range checksQEMU {
+		rec := recs[i]
+		if rec.Kind != check.kind || rec.Line != check.line || rec.Info != check.info {
+			t.Errorf("%d: wrong record (want=%v %v %q, get=%v %v %q)", i, check.kind, check.line, check.info, rec.Kind, rec.Line, rec.Info)
+			continue
+		}
+		if rec.Kind != syscallinfo.TraceSyscall {
+			continue
+		}
+		if rec.PID != 4321 || rec.Syscall.Name != check.name {
+			t.Errorf("%d: wrong syscall (want=4321 %v, get=%v %v)", i, check.name, rec.PID, rec.Syscall.Name)
+		}
+		if len(rec.Args) != len(check.args) {
+			t.Errorf("%d: wrong number of args (want=%v, get=%v)", i, len(check.args), len(rec.Args))
+			continue
+		}
+		for j, arg := range check.args {
+			if rec.Args[j].Value != arg {
+				t.Errorf("%d: wrong arg %d (want=%#x, get=%#x)", i, j, arg, rec.Args[j].Value)
+			}
+		}
+		if rec.Ret.Value != check.ret || rec.Status != check.status {
+			t.Errorf("%d: wrong result (want=%#x %v, get=%#x %v)", i, check.ret, check.status, rec.Ret.Value, rec.Status)
+		}
+		if _, err := rec.Call(); err != nil {
+			t.Errorf("%d: wrong error (want=nil, get=%v)", i, err)
+		}
+	}
+	if recs[6].Signal != 11 {
+		t.Errorf("wrong signal (want=11, get=%v)", recs[6].Signal)
+	}
+}
+
+func TestQEMUReader_syntaxError(t *testing.T) {
+	const log = "4321 brk(NULL) = 0x0804c000\nhello\n"
+	r := syscallinfo.NewResolver(linux_386.SyscallTable)
+	qr := syscallinfo.NewQEMUReader(strings.NewReader(log), r)
+	if _, err := qr.Read(); err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	_, err := qr.Read()
+	serr, ok := err.(*syscallinfo.TraceSyntaxError)
+	if !ok || serr.Format != "qemu" || serr.Line != 2 {
+		t.Errorf("wrong error (want=qemu: line 2, get=%v)", err)
+	}
+}
+
+const qemuArchLog = `77 openat(AT_FDCWD,"/etc/hostname",O_RDONLY) = 3
+77 read(3,0x4000800000,4096) = 9
+77 exit_group(0)
+`
+
+var checksQEMUArches = []struct {
+	target string
+	nums   []int
+	call   string
+}{
+	{"qemu-arm", []int{322, 3, 248}, "openat(0xffffff9c, 0x00000000, 0x00000000, 0x00000000) = 0x00000003"},
+	{"qemu-aarch64", []int{56, 63, 94}, "openat(0xffffffffffffff9c, 0x00000000, 0x00000000, 0x00000000) = 0x00000003"},
+	{"qemu-riscv64", []int{56, 63, 94}, "openat(0xffffffffffffff9c, 0x00000000, 0x00000000, 0x00000000) = 0x00000003"},
+}
+
+func TestQEMUReader_arches(t *testing.T) {
+	for _, check := range checksQEMUArches {
+		arch, err := syscallinfo.ArchFromQEMU(check.target)
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		r, err := syscallinfo.NewArchResolver(arch)
+		if err != nil {
+			t.Errorf("%v: wrong error (want=nil, get=%v)", check.target, err)
+			continue
+		}
+		recs, err := syscallinfo.NewQEMUReader(strings.NewReader(qemuArchLog), r).ReadAll()
+		if err != nil {
+			t.Errorf("%v: wrong error (want=nil, get=%v)", check.target, err)
+			continue
+		}
+		if len(recs) != len(check.nums) {
+			t.Errorf("%v: wrong number of records (want=%v, get=%v)", check.target, len(check.nums), len(recs))
+			continue
+		}
+		for i, num := range check.nums {
+			if sc := recs[i].Syscall; sc.Num != num || sc.Status == syscallinfo.SyscallUnknownNumber {
+				t.Errorf("%v: wrong syscall for %v (want=%v, get=%v %v)", check.target, sc.Name, num, sc.Num, sc.Status)
+			}
+		}
+		scc, err := recs[0].Call()
+		if err != nil {
+			t.Errorf("%v: wrong error (want=nil, get=%v)", check.target, err)
+			continue
+		}
+		if get := scc.String(); get != check.call {
+			t.Errorf("%v: wrong call (want=%v, get=%v)", check.target, check.call, get)
+		}
+	}
+}
+
+var checksArchFromQEMU = []struct {
+	target string
+	arch   string
+	ok     bool
+}{
+	{"i386", "linux_386", true},
+	{"qemu-x86_64", "linux_amd64", true},
+	{"arm", "linux_arm", true},
+	{"aarch64", "linux_arm64", true},
+	{"riscv64", "linux_riscv64", true},
+	{"mips", "", false},
+}
+
+func TestArchFromQEMU(t *testing.T) {
+	for _, check := range checksArchFromQEMU {
+		arch, err := syscallinfo.ArchFromQEMU(check.target)
+		if arch != check.arch || (err == nil) != check.ok {
+			t.Errorf("%v: wrong arch (want=%v %v, get=%v %v)", check.target, check.arch, check.ok, arch, err)
+		}
+	}
+}
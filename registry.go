@@ -0,0 +1,140 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo
+
+import (
+	"sort"
+	"sync"
+)
+
+var (
+	tablesMu  sync.RWMutex
+	tables    = map[string]SyscallTable{}
+	snapshots = map[string]map[KernelVersion]SyscallTable{}
+)
+
+// Register makes a syscall table available under the provided arch name
+// (e.g. "linux_amd64"). The table packages call it from their init
+// functions, so importing them is enough to register their tables. If
+// Register is called twice with the same name, it panics.
+func Register(arch string, tbl SyscallTable) {
+	tablesMu.Lock()
+	defer tablesMu.Unlock()
+	if tbl == nil {
+		panic("syscallinfo: Register table is nil")
+	}
+	if _, dup := tables[arch]; dup {
+		panic("syscallinfo: Register called twice for arch " + arch)
+	}
+	tables[arch] = tbl
+}
+
+// Table returns the syscall table registered under the provided arch name.
+func Table(arch string) (SyscallTable, error) {
+	tablesMu.RLock()
+	defer tablesMu.RUnlock()
+	tbl, ok := tables[arch]
+	if !ok {
+		return nil, &UnknownArchError{Arch: arch}
+	}
+	return tbl, nil
+}
+
+// Arches returns a sorted list of the names of the registered archs.
+func Arches() []string {
+	tablesMu.RLock()
+	defer tablesMu.RUnlock()
+	var arches []string
+	for arch := range tables {
+		arches = append(arches, arch)
+	}
+	sort.Strings(arches)
+	return arches
+}
+
+// RegisterSnapshot makes a syscall table available as the snapshot of the
+// provided arch taken at the given kernel release (e.g. "4.0"). If the
+// release is not a valid kernel version or RegisterSnapshot is called twice
+// with the same arch and release, it panics.
+func RegisterSnapshot(arch, release string, tbl SyscallTable) {
+	v, err := ParseKernelVersion(release)
+	if err != nil {
+		panic("syscallinfo: RegisterSnapshot " + err.Error())
+	}
+	tablesMu.Lock()
+	defer tablesMu.Unlock()
+	if tbl == nil {
+		panic("syscallinfo: RegisterSnapshot table is nil")
+	}
+	if snapshots[arch] == nil {
+		snapshots[arch] = map[KernelVersion]SyscallTable{}
+	}
+	if _, dup := snapshots[arch][v]; dup {
+		panic("syscallinfo: RegisterSnapshot called twice for " + arch + " " + release)
+	}
+	snapshots[arch][v] = tbl
+}
+
+// Snapshot returns the most recent snapshot of the provided arch that is not
+// newer than the kernel version v.
+func Snapshot(arch string, v KernelVersion) (SyscallTable, error) {
+	tablesMu.RLock()
+	defer tablesMu.RUnlock()
+	var (
+		tbl     SyscallTable
+		release KernelVersion
+	)
+	for sv, stbl := range snapshots[arch] {
+		if v.Less(sv) {
+			continue
+		}
+		if tbl == nil || release.Less(sv) {
+			tbl, release = stbl, sv
+		}
+	}
+	if tbl == nil {
+		return nil, &UnknownArchError{Arch: arch, Release: v}
+	}
+	return tbl, nil
+}
+
+// Snapshots returns the releases of the registered snapshots of the provided
+// arch, sorted from oldest to newest.
+func Snapshots(arch string) []KernelVersion {
+	tablesMu.RLock()
+	defer tablesMu.RUnlock()
+	var releases []KernelVersion
+	for v := range snapshots[arch] {
+		releases = append(releases, v)
+	}
+	sort.Sort(byVersion(releases))
+	return releases
+}
+
+// NewArchResolver returns a syscall resolver for the table registered under
+// the provided arch name.
+func NewArchResolver(arch string) (Resolver, error) {
+	tbl, err := Table(arch)
+	if err != nil {
+		return Resolver{}, err
+	}
+	return Resolver{tbl: tbl, arch: arch}, nil
+}
+
+// NewSnapshotResolver returns a syscall resolver for the most recent
+// snapshot of the provided arch that is not newer than the kernel version v.
+func NewSnapshotResolver(arch string, v KernelVersion) (Resolver, error) {
+	tbl, err := Snapshot(arch, v)
+	if err != nil {
+		return Resolver{}, err
+	}
+	return Resolver{tbl: tbl, arch: arch}, nil
+}
+
+type byVersion []KernelVersion
+
+func (s byVersion) Len() int           { return len(s) }
+func (s byVersion) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byVersion) Less(i, j int) bool { return s[i].Less(s[j]) }
@@ -0,0 +1,161 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+)
+
+// SeccompAction is the action taken by seccomp when a rule matches.
+type SeccompAction string
+
+// Seccomp actions defined by the OCI runtime specification.
+const (
+	ActKill        SeccompAction = "SCMP_ACT_KILL"
+	ActKillProcess SeccompAction = "SCMP_ACT_KILL_PROCESS"
+	ActKillThread  SeccompAction = "SCMP_ACT_KILL_THREAD"
+	ActTrap        SeccompAction = "SCMP_ACT_TRAP"
+	ActErrno       SeccompAction = "SCMP_ACT_ERRNO"
+	ActTrace       SeccompAction = "SCMP_ACT_TRACE"
+	ActAllow       SeccompAction = "SCMP_ACT_ALLOW"
+	ActLog         SeccompAction = "SCMP_ACT_LOG"
+	ActNotify      SeccompAction = "SCMP_ACT_NOTIFY"
+)
+
+// A SeccompProfile represents the linux.seccomp section of the OCI runtime
+// specification. The extra fields used by Docker's default profile (archMap,
+// includes, excludes and comment) are also supported.
+type SeccompProfile struct {
+	DefaultAction    SeccompAction    `json:"defaultAction"`
+	DefaultErrnoRet  *uint            `json:"defaultErrnoRet,omitempty"`
+	Architectures    []string         `json:"architectures,omitempty"`
+	ArchMap          []SeccompArchMap `json:"archMap,omitempty"`
+	Flags            []string         `json:"flags,omitempty"`
+	ListenerPath     string           `json:"listenerPath,omitempty"`
+	ListenerMetadata string           `json:"listenerMetadata,omitempty"`
+	Syscalls         []SeccompSyscall `json:"syscalls,omitempty"`
+}
+
+// SeccompArchMap links an architecture with its sub-architectures. It is
+// used by Docker profiles.
+type SeccompArchMap struct {
+	Arch     string   `json:"architecture"`
+	SubArchs []string `json:"subArchitectures"`
+}
+
+// A SeccompSyscall is a seccomp rule that applies to a set of syscalls.
+type SeccompSyscall struct {
+	Names    []string       `json:"names"`
+	Action   SeccompAction  `json:"action"`
+	ErrnoRet *uint          `json:"errnoRet,omitempty"`
+	Args     []SeccompArg   `json:"args,omitempty"`
+	Comment  string         `json:"comment,omitempty"`
+	Includes *SeccompFilter `json:"includes,omitempty"`
+	Excludes *SeccompFilter `json:"excludes,omitempty"`
+}
+
+// A SeccompArg is a condition over a syscall argument.
+type SeccompArg struct {
+	Index    uint   `json:"index"`
+	Value    uint64 `json:"value"`
+	ValueTwo uint64 `json:"valueTwo,omitempty"`
+	Op       string `json:"op"`
+}
+
+// A SeccompFilter restricts when a Docker rule applies.
+type SeccompFilter struct {
+	Caps      []string `json:"caps,omitempty"`
+	Arches    []string `json:"arches,omitempty"`
+	MinKernel string   `json:"minKernel,omitempty"`
+}
+
+// ReadSeccompProfile parses a JSON seccomp profile from r.
+func ReadSeccompProfile(r io.Reader) (*SeccompProfile, error) {
+	p := &SeccompProfile{}
+	if err := json.NewDecoder(r).Decode(p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// WriteSeccompProfile writes p to w as indented JSON.
+func WriteSeccompProfile(w io.Writer, p *SeccompProfile) error {
+	data, err := json.MarshalIndent(p, "", "\t")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	_, err = w.Write(data)
+	return err
+}
+
+// CheckSeccompProfile validates the syscall names listed in p against the
+// resolver's syscall table. It returns the sorted list of names unknown to
+// the table, or nil if all of them are known.
+func (r Resolver) CheckSeccompProfile(p *SeccompProfile) []string {
+	seen := map[string]bool{}
+	var unknown []string
+	for _, rule := range p.Syscalls {
+		for _, name := range rule.Names {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			if _, err := r.SyscallName(name); err != nil {
+				unknown = append(unknown, name)
+			}
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+// seccompArches links the names of the archs with the architectures of
+// the OCI runtime specification.
+var seccompArches = map[string]string{
+	"linux_amd64":   "SCMP_ARCH_X86_64",
+	"linux_386":     "SCMP_ARCH_X86",
+	"linux_arm":     "SCMP_ARCH_ARM",
+	"linux_arm64":   "SCMP_ARCH_AARCH64",
+	"linux_riscv64": "SCMP_ARCH_RISCV64",
+}
+
+// GenerateSeccompProfile returns a profile for the arch (e.g. "linux_386")
+// that allows the syscalls used by the provided calls and returns EPERM for
+// any other one. Calls to unknown syscall numbers are not allowed, since
+// they have no name.
+func GenerateSeccompProfile(arch string, calls []*SyscallCall) (*SeccompProfile, error) {
+	scmpArch, ok := seccompArches[arch]
+	if !ok {
+		return nil, &UnknownArchError{Arch: arch}
+	}
+
+	seen := map[string]bool{}
+	var names []string
+	for _, scc := range calls {
+		name := scc.sc.Name
+		if scc.sc.Status == SyscallUnknownNumber || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	eperm := uint(1)
+	p := &SeccompProfile{
+		DefaultAction:   ActErrno,
+		DefaultErrnoRet: &eperm,
+		Architectures:   []string{scmpArch},
+	}
+	if len(names) > 0 {
+		p.Syscalls = []SeccompSyscall{
+			{Names: names, Action: ActAllow},
+		}
+	}
+	return p, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jroimartin/syscallinfo"
+	"github.com/jroimartin/syscallinfo/linux_386"
+	"github.com/jroimartin/syscallinfo/linux_amd64"
+)
+
+const seccompProfile = `{
+	"defaultAction": "SCMP_ACT_ERRNO",
+	"defaultErrnoRet": 1,
+	"archMap": [
+		{
+			"architecture": "SCMP_ARCH_X86_64",
+			"subArchitectures": ["SCMP_ARCH_X86", "SCMP_ARCH_X32"]
+		}
+	],
+	"syscalls": [
+		{
+			"names": ["read", "write", "open", "socketcall"],
+			"action": "SCMP_ACT_ALLOW"
+		},
+		{
+			"names": ["personality"],
+			"action": "SCMP_ACT_ALLOW",
+			"args": [{"index": 0, "value": 8, "op": "SCMP_CMP_EQ"}],
+			"includes": {"arches": ["amd64"]}
+		},
+		{
+			"names": ["foobar"],
+			"action": "SCMP_ACT_ALLOW"
+		}
+	]
+}`
+
+var checksSeccomp = []struct {
+	tbl     syscallinfo.SyscallTable
+	unknown []string
+}{
+	{linux_386.SyscallTable, []string{"foobar"}},
+	{linux_amd64.SyscallTable, []string{"foobar", "socketcall"}},
+}
+
+func TestResolver_CheckSeccompProfile(t *testing.T) {
+	p, err := syscallinfo.ReadSeccompProfile(strings.NewReader(seccompProfile))
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	for _, check := range checksSeccomp {
+		r := syscallinfo.NewResolver(check.tbl)
+		unknown := r.CheckSeccompProfile(p)
+		if !reflect.DeepEqual(unknown, check.unknown) {
+			t.Errorf("wrong unknown syscalls (want=%v, get=%v)", check.unknown, unknown)
+		}
+	}
+}
+
+func TestWriteSeccompProfile(t *testing.T) {
+	p, err := syscallinfo.ReadSeccompProfile(strings.NewReader(seccompProfile))
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	var buf bytes.Buffer
+	if err := syscallinfo.WriteSeccompProfile(&buf, p); err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	p2, err := syscallinfo.ReadSeccompProfile(&buf)
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	if !reflect.DeepEqual(p, p2) {
+		t.Errorf("profile does not round-trip (want=%+v, get=%+v)", p, p2)
+	}
+}
+
+func TestGenerateSeccompProfile(t *testing.T) {
+	r := syscallinfo.NewResolver(linux_386.SyscallTable)
+	var calls []*syscallinfo.SyscallCall
+	for _, n := range []int{4, 3, 4, 6} {
+		sc, err := r.SyscallN(n)
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		scc, err := syscallinfo.NewSyscallCall(sc, 0, 0, 0, 0)
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		calls = append(calls, scc)
+	}
+	scc, err := syscallinfo.NewSyscallCall(syscallinfo.UnknownSyscall(500), 0, 0, 0, 0, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	calls = append(calls, scc)
+
+	p, err := syscallinfo.GenerateSeccompProfile("linux_386", calls)
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	if want := []string{"SCMP_ARCH_X86"}; !reflect.DeepEqual(p.Architectures, want) {
+		t.Errorf("wrong architectures (want=%v, get=%v)", want, p.Architectures)
+	}
+	if p.DefaultAction != syscallinfo.ActErrno {
+		t.Errorf("wrong default action (want=%v, get=%v)", syscallinfo.ActErrno, p.DefaultAction)
+	}
+	if len(p.Syscalls) != 1 {
+		t.Fatalf("wrong number of rules (want=1, get=%v)", len(p.Syscalls))
+	}
+	want := []string{"close", "read", "write"}
+	if !reflect.DeepEqual(p.Syscalls[0].Names, want) {
+		t.Errorf("wrong names (want=%v, get=%v)", want, p.Syscalls[0].Names)
+	}
+	if unknown := r.CheckSeccompProfile(p); unknown != nil {
+		t.Errorf("wrong unknown syscalls (want=nil, get=%v)", unknown)
+	}
+
+	if _, err := syscallinfo.GenerateSeccompProfile("linux_mips", calls); err == nil {
+		t.Errorf("wrong error (want=unknown arch, get=nil)")
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// signalNames contains the names of the Linux signals as defined for x86.
+var signalNames = map[int]string{
+	1:  "SIGHUP",
+	2:  "SIGINT",
+	3:  "SIGQUIT",
+	4:  "SIGILL",
+	5:  "SIGTRAP",
+	6:  "SIGABRT",
+	7:  "SIGBUS",
+	8:  "SIGFPE",
+	9:  "SIGKILL",
+	10: "SIGUSR1",
+	11: "SIGSEGV",
+	12: "SIGUSR2",
+	13: "SIGPIPE",
+	14: "SIGALRM",
+	15: "SIGTERM",
+	16: "SIGSTKFLT",
+	17: "SIGCHLD",
+	18: "SIGCONT",
+	19: "SIGSTOP",
+	20: "SIGTSTP",
+	21: "SIGTTIN",
+	22: "SIGTTOU",
+	23: "SIGURG",
+	24: "SIGXCPU",
+	25: "SIGXFSZ",
+	26: "SIGVTALRM",
+	27: "SIGPROF",
+	28: "SIGWINCH",
+	29: "SIGIO",
+	30: "SIGPWR",
+	31: "SIGSYS",
+}
+
+// Real-time signals range.
+const (
+	sigRtMin = 32
+	sigRtMax = 64
+)
+
+// SignalName returns the name of the signal sig (e.g. "SIGCHLD"). Real-time
+// signals are named "SIGRTMIN+n".
+func SignalName(sig int) string {
+	if name, ok := signalNames[sig]; ok {
+		return name
+	}
+	if sig == sigRtMin {
+		return "SIGRTMIN"
+	}
+	if sig > sigRtMin && sig <= sigRtMax {
+		return fmt.Sprintf("SIGRTMIN+%d", sig-sigRtMin)
+	}
+	return strconv.Itoa(sig)
+}
+
+// ParseSignal returns the number of the signal with the given name. The
+// "SIG" prefix is optional and numbers are also accepted.
+func ParseSignal(name string) (int, error) {
+	if n, err := strconv.Atoi(name); err == nil {
+		if n <= 0 || n > sigRtMax {
+			return 0, &ParseError{Kind: "signal", Value: name}
+		}
+		return n, nil
+	}
+	uname := strings.ToUpper(name)
+	if !strings.HasPrefix(uname, "SIG") {
+		uname = "SIG" + uname
+	}
+	for n, s := range signalNames {
+		if s == uname {
+			return n, nil
+		}
+	}
+	switch {
+	case uname == "SIGIOT":
+		return 6, nil
+	case uname == "SIGPOLL":
+		return 29, nil
+	case uname == "SIGRTMIN":
+		return sigRtMin, nil
+	case strings.HasPrefix(uname, "SIGRTMIN+"):
+		n, err := strconv.Atoi(strings.TrimPrefix(uname, "SIGRTMIN+"))
+		if err == nil && n >= 0 && sigRtMin+n <= sigRtMax {
+			return sigRtMin + n, nil
+		}
+	}
+	return 0, &ParseError{Kind: "signal", Value: name}
+}
@@ -0,0 +1,331 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// stracePIDRE matches the PID printed by strace -f, which is enclosed
+	// in "[pid N]" when the output is a terminal.
+	stracePIDRE = regexp.MustCompile(`^(?:\[pid\s+(\d+)\]|(\d+))\s+`)
+
+	// straceTimeRE matches the timestamps printed by strace -t, -tt, -ttt
+	// and -r.
+	straceTimeRE = regexp.MustCompile(`^(?:(\d{2}):(\d{2}):(\d{2})|(\d+))(?:\.(\d+))?\s+`)
+
+	straceCallRE     = regexp.MustCompile(`^([a-z_][a-z0-9_]*)\((.*)$`)
+	straceResumedRE  = regexp.MustCompile(`^<\.\.\. ([a-z_][a-z0-9_]*) resumed>\s?(.*)$`)
+	straceSignalRE   = regexp.MustCompile(`^--- (?:stopped by )?(SIG[A-Z0-9+]+)(?: (.*))? ---$`)
+	straceExitRE     = regexp.MustCompile(`^\+\+\+ exited with (\d+) \+\+\+$`)
+	straceKilledRE   = regexp.MustCompile(`^\+\+\+ killed by (SIG[A-Z0-9+]+)(?: \((core dumped)\))? \+\+\+$`)
+	straceDurationRE = regexp.MustCompile(`\s+<(\d+)(?:\.(\d+))?>$`)
+	straceRetRE      = regexp.MustCompile(`^=\s+(\?|-?\w+(?:<[^>]*>)?)(?:\s+(E[A-Z0-9_]+))?(?:\s+\((.*)\))?$`)
+)
+
+const (
+	straceUnfinished = "<unfinished ...>"
+	straceDetached   = "<detached ...>"
+)
+
+// A StraceReader reads the records of an strace log. It supports the
+// output of the -f, -t, -tt, -ttt, -r, -T and -y options.
+//
+// Calls interrupted by other processes ("<unfinished ...>") are merged with
+// their resumption ("<... resumed>") and returned when they are resumed,
+// with the PID and timestamp of their first line. Calls that are never
+// resumed are returned with the status StatusUnfinished when their process
+// exits or at the end of the log.
+type StraceReader struct {
+	r       Resolver
+	s       *bufio.Scanner
+	line    int
+	pending map[int]*straceCall
+	queue   []*TraceRecord
+}
+
+// A straceCall is an unfinished call.
+type straceCall struct {
+	rec  *TraceRecord
+	name string
+	args string
+}
+
+// NewStraceReader returns a StraceReader that reads from rd and resolves
+// syscall names using r.
+func NewStraceReader(rd io.Reader, r Resolver) *StraceReader {
+	return &StraceReader{
+		r:       r,
+		s:       bufio.NewScanner(rd),
+		pending: map[int]*straceCall{},
+	}
+}
+
+// Read returns the next record of the log. It returns io.EOF when there are
+// no more records. Lines that cannot be parsed are reported as
+// TraceSyntaxError.
+func (sr *StraceReader) Read() (*TraceRecord, error) {
+	for len(sr.queue) == 0 {
+		if !sr.s.Scan() {
+			if err := sr.s.Err(); err != nil {
+				return nil, err
+			}
+			if len(sr.pending) == 0 {
+				return nil, io.EOF
+			}
+			sr.flush(-1)
+			break
+		}
+		sr.line++
+		if err := sr.parseLine(sr.s.Text()); err != nil {
+			return nil, err
+		}
+	}
+	rec := sr.queue[0]
+	sr.queue = sr.queue[1:]
+	return rec, nil
+}
+
+// ReadAll reads all the remaining records of the log.
+func (sr *StraceReader) ReadAll() ([]*TraceRecord, error) {
+	return ReadAllRecords(sr)
+}
+
+func (sr *StraceReader) parseLine(line string) error {
+	text := strings.TrimSpace(line)
+	if text == "" || strings.HasPrefix(text, "strace: ") || strings.HasPrefix(text, "[ Process ") {
+		return nil
+	}
+
+	rec := &TraceRecord{Line: sr.line, wordSize: sr.r.wordSize()}
+	if m := stracePIDRE.FindStringSubmatch(text); m != nil {
+		rec.PID, _ = strconv.Atoi(m[1] + m[2])
+		text = text[len(m[0]):]
+	}
+	if m := straceTimeRE.FindStringSubmatch(text); m != nil {
+		rec.Time = parseStraceTime(m)
+		text = text[len(m[0]):]
+	}
+
+	if m := straceSignalRE.FindStringSubmatch(text); m != nil {
+		sig, err := ParseSignal(m[1])
+		if err != nil {
+			return sr.syntaxError(line)
+		}
+		rec.Kind = TraceSignal
+		rec.Signal = sig
+		rec.Info = m[2]
+		sr.queue = append(sr.queue, rec)
+		return nil
+	}
+	if m := straceExitRE.FindStringSubmatch(text); m != nil {
+		rec.Kind = TraceExit
+		rec.ExitCode, _ = strconv.Atoi(m[1])
+		sr.flush(rec.PID)
+		sr.queue = append(sr.queue, rec)
+		return nil
+	}
+	if m := straceKilledRE.FindStringSubmatch(text); m != nil {
+		sig, err := ParseSignal(m[1])
+		if err != nil {
+			return sr.syntaxError(line)
+		}
+		rec.Kind = TraceKilled
+		rec.Signal = sig
+		rec.Info = m[2]
+		sr.flush(rec.PID)
+		sr.queue = append(sr.queue, rec)
+		return nil
+	}
+	if m := straceResumedRE.FindStringSubmatch(text); m != nil {
+		name, rest := m[1], m[2]
+		if call, ok := sr.pending[rec.PID]; ok {
+			if call.name != name {
+				return sr.syntaxError(line)
+			}
+			delete(sr.pending, rec.PID)
+			call.rec.Line = rec.Line
+			rec = call.rec
+			rest = call.args + rest
+		}
+		if !sr.complete(rec, name, rest) {
+			return sr.syntaxError(line)
+		}
+		sr.queue = append(sr.queue, rec)
+		return nil
+	}
+	if m := straceCallRE.FindStringSubmatch(text); m != nil {
+		name, rest := m[1], m[2]
+		if strings.HasSuffix(rest, straceUnfinished) {
+			sr.flush(rec.PID)
+			args := strings.TrimSuffix(rest, straceUnfinished)
+			sr.pending[rec.PID] = &straceCall{rec: rec, name: name, args: args}
+			return nil
+		}
+		if strings.HasSuffix(rest, straceDetached) {
+			sr.unfinished(rec, name, strings.TrimSuffix(rest, straceDetached))
+			rec.Status = StatusDetached
+			sr.queue = append(sr.queue, rec)
+			return nil
+		}
+		if !sr.complete(rec, name, rest) {
+			return sr.syntaxError(line)
+		}
+		sr.queue = append(sr.queue, rec)
+		return nil
+	}
+	return sr.syntaxError(line)
+}
+
+// complete fills rec with the call to the syscall name. rest is the text
+// that follows the opening parenthesis, which includes the arguments, the
+// return value and the duration of the call. It returns false if rest
+// cannot be parsed.
+func (sr *StraceReader) complete(rec *TraceRecord, name, rest string) bool {
+	args, tail, ok := splitStraceArgs(rest)
+	if !ok {
+		return false
+	}
+	sr.setCall(rec, name, args)
+
+	tail = strings.TrimSpace(tail)
+	if m := straceDurationRE.FindStringSubmatch(tail); m != nil {
+		rec.Duration = parseSeconds(m[1], m[2])
+		tail = tail[:len(tail)-len(m[0])]
+	}
+	m := straceRetRE.FindStringSubmatch(tail)
+	if m == nil {
+		return false
+	}
+	rec.Errno = m[2]
+	rec.Info = m[3]
+	if m[1] == "?" {
+		rec.Ret = TraceValue{Text: m[1]}
+		rec.Status = StatusUnavailable
+		return true
+	}
+	rec.Ret = parseTraceValue(m[1])
+	rec.Status = StatusSuccessful
+	if rec.Errno != "" {
+		rec.Status = StatusFailed
+	}
+	return true
+}
+
+// unfinished fills rec with a call to the syscall name that did not return.
+// args is the text printed before the call was interrupted.
+func (sr *StraceReader) unfinished(rec *TraceRecord, name, args string) {
+	list, _, _ := splitStraceArgs(args)
+	sr.setCall(rec, name, list)
+	rec.Status = StatusUnfinished
+}
+
+// setCall sets the syscall and arguments of rec.
+func (sr *StraceReader) setCall(rec *TraceRecord, name string, args []string) {
+	rec.Kind = TraceSyscall
+	rec.Syscall = resolveName(sr.r, name)
+	rec.Args = make([]TraceValue, len(args))
+	for i, arg := range args {
+		rec.Args[i] = parseTraceValue(arg)
+	}
+}
+
+// flush queues the unfinished call of the process pid as a call that did
+// not return. If pid is -1, the unfinished calls of all the processes are
+// queued, sorted by PID.
+func (sr *StraceReader) flush(pid int) {
+	var pids []int
+	for p := range sr.pending {
+		if pid == -1 || p == pid {
+			pids = append(pids, p)
+		}
+	}
+	sort.Ints(pids)
+	for _, p := range pids {
+		call := sr.pending[p]
+		delete(sr.pending, p)
+		sr.unfinished(call.rec, call.name, call.args)
+		sr.queue = append(sr.queue, call.rec)
+	}
+}
+
+func (sr *StraceReader) syntaxError(line string) error {
+	return &TraceSyntaxError{Format: "strace", Line: sr.line, Text: line}
+}
+
+// splitStraceArgs splits the arguments of a call printed by strace. s is the
+// text that follows the opening parenthesis. It returns the arguments and
+// the text that follows the closing parenthesis. If the closing parenthesis
+// is not found, ok is false and the arguments found so far are returned.
+func splitStraceArgs(s string) (args []string, tail string, ok bool) {
+	var (
+		depth   int
+		quoted  bool
+		comment bool
+		start   int
+	)
+	add := func(end int) {
+		if arg := strings.TrimSpace(s[start:end]); arg != "" {
+			args = append(args, arg)
+		}
+		start = end + 1
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case comment:
+			if strings.HasPrefix(s[i:], "*/") {
+				comment = false
+				i++
+			}
+		case quoted:
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				quoted = false
+			}
+		case c == '"':
+			quoted = true
+		case strings.HasPrefix(s[i:], "/*"):
+			comment = true
+			i++
+		case c == '(' || c == '[' || c == '{':
+			depth++
+		case c == ']' || c == '}':
+			depth--
+		case c == ')':
+			if depth == 0 {
+				add(i)
+				return args, s[i+1:], true
+			}
+			depth--
+		case c == ',' && depth == 0:
+			add(i)
+		}
+	}
+	if start < len(s) {
+		add(len(s))
+	}
+	return args, "", false
+}
+
+// parseStraceTime returns the timestamp matched by straceTimeRE.
+func parseStraceTime(m []string) time.Duration {
+	secs := m[4]
+	if m[1] != "" {
+		h, _ := strconv.Atoi(m[1])
+		min, _ := strconv.Atoi(m[2])
+		s, _ := strconv.Atoi(m[3])
+		secs = strconv.Itoa(h*3600 + min*60 + s)
+	}
+	return parseSeconds(secs, m[5])
+}
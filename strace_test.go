@@ -0,0 +1,266 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jroimartin/syscallinfo"
+	"github.com/jroimartin/syscallinfo/linux_386"
+	"github.com/jroimartin/syscallinfo/linux_amd64"
+)
+
+const straceLog = `1234  10:20:30.000100 execve("/bin/sh", ["sh", "-c", "ls"], 0x7ffd1b0 /* 20 vars */) = 0 <0.000300>
+1234  10:20:30.000500 openat(AT_FDCWD, "/etc/ld.so.cache", O_RDONLY|O_CLOEXEC) = 3</etc/ld.so.cache> <0.000020>
+1234  10:20:30.000700 openat(AT_FDCWD, "/nonexistent", O_WRONLY|O_CREAT|O_TRUNC, 0644) = -1 ENOENT (No such file or directory) <0.000011>
+1235  10:20:30.000900 write(1</dev/pts/0>, "a, b) = 3\n", 10 <unfinished ...>
+1234  10:20:30.001000 wait4(-1,  <unfinished ...>
+1235  10:20:30.001100 <... write resumed>) = 10 <0.000200>
+1235  10:20:30.001200 exit_group(0)     = ?
+1235  10:20:30.001300 +++ exited with 0 +++
+1234  10:20:30.001400 <... wait4 resumed>[{WIFEXITED(s) && WEXITSTATUS(s) == 0}], 0, NULL) = 1235 <0.000400>
+1234  10:20:30.001500 --- SIGCHLD {si_signo=SIGCHLD, si_code=CLD_EXITED, si_pid=1235} ---
+1234  10:20:30.001600 kill(1236, SIGTERM) = 0
+1234  10:20:30.001700 mmap(NULL, 8192, PROT_READ|PROT_WRITE, MAP_PRIVATE|MAP_ANONYMOUS, -1, 0) = 0x7f2a3c000000
+1234  10:20:30.001800 syscall_0x1f4(0x1, 0x2, 0, 0, 0, 0) = -1 ENOSYS (Function not implemented)
+1234  10:20:30.001900 read(0,  <unfinished ...>
+1234  10:20:30.002000 +++ killed by SIGKILL +++
+`
+
+var checksStrace = []struct {
+	kind   syscallinfo.TraceKind
+	line   int
+	pid    int
+	time   time.Duration
+	name   string
+	args   []string
+	ret    string
+	errno  string
+	status syscallinfo.CallStatus
+	signal int
+	output string
+}{
+	{
+		syscallinfo.TraceSyscall, 1, 1234, 10*time.Hour + 20*time.Minute + 30*time.Second + 100*time.Microsecond,
+		"execve", []string{`"/bin/sh"`, `["sh", "-c", "ls"]`, "0x7ffd1b0 /* 20 vars */"}, "0", "", syscallinfo.StatusSuccessful, 0,
+		"execve(0x00000000, 0x00000000, 0x07ffd1b0) = 0x00000000",
+	},
+	{
+		syscallinfo.TraceSyscall, 2, 1234, 10*time.Hour + 20*time.Minute + 30*time.Second + 500*time.Microsecond,
+		"openat", []string{"AT_FDCWD", `"/etc/ld.so.cache"`, "O_RDONLY|O_CLOEXEC"}, "3</etc/ld.so.cache>", "", syscallinfo.StatusSuccessful, 0,
+		"openat(0xffffffffffffff9c, 0x00000000, 0x00080000, 0x00000000) = 0x00000003",
+	},
+	{
+		syscallinfo.TraceSyscall, 3, 1234, 10*time.Hour + 20*time.Minute + 30*time.Second + 700*time.Microsecond,
+		"openat", []string{"AT_FDCWD", `"/nonexistent"`, "O_WRONLY|O_CREAT|O_TRUNC", "0644"}, "-1", "ENOENT", syscallinfo.StatusFailed, 0,
+		"openat(0xffffffffffffff9c, 0x00000000, 0x00000241, 0x000001a4) = 0xffffffffffffffff",
+	},
+	{
+		syscallinfo.TraceSyscall, 6, 1235, 10*time.Hour + 20*time.Minute + 30*time.Second + 900*time.Microsecond,
+		"write", []string{"1</dev/pts/0>", `"a, b) = 3\n"`, "10"}, "10", "", syscallinfo.StatusSuccessful, 0,
+		"write(0x00000001, 0x00000000, 0x0000000a) = 0x0000000a",
+	},
+	{
+		syscallinfo.TraceSyscall, 7, 1235, 10*time.Hour + 20*time.Minute + 30*time.Second + 1200*time.Microsecond,
+		"exit_group", []string{"0"}, "?", "", syscallinfo.StatusUnavailable, 0,
+		"exit_group(0x00000000) = 0x00000000",
+	},
+	{
+		syscallinfo.TraceExit, 8, 1235, 10*time.Hour + 20*time.Minute + 30*time.Second + 1300*time.Microsecond,
+		"", nil, "", "", 0, 0, "",
+	},
+	{
+		syscallinfo.TraceSyscall, 9, 1234, 10*time.Hour + 20*time.Minute + 30*time.Second + 1000*time.Microsecond,
+		"wait4", []string{"-1", "[{WIFEXITED(s) && WEXITSTATUS(s) == 0}]", "0", "NULL"}, "1235", "", syscallinfo.StatusSuccessful, 0,
+		"wait4(0xffffffffffffffff, 0x00000000, 0x00000000, 0x00000000) = 0x000004d3",
+	},
+	{
+		syscallinfo.TraceSignal, 10, 1234, 10*time.Hour + 20*time.Minute + 30*time.Second + 1500*time.Microsecond,
+		"", nil, "", "", 0, 17, "",
+	},
+	{
+		syscallinfo.TraceSyscall, 11, 1234, 10*time.Hour + 20*time.Minute + 30*time.Second + 1600*time.Microsecond,
+		"kill", []string{"1236", "SIGTERM"}, "0", "", syscallinfo.StatusSuccessful, 0,
+		"kill(0x000004d4, 0x0000000f) = 0x00000000",
+	},
+	{
+		syscallinfo.TraceSyscall, 12, 1234, 10*time.Hour + 20*time.Minute + 30*time.Second + 1700*time.Microsecond,
+		"mmap", []string{"NULL", "8192", "PROT_READ|PROT_WRITE", "MAP_PRIVATE|MAP_ANONYMOUS", "-1", "0"}, "0x7f2a3c000000", "", syscallinfo.StatusSuccessful, 0,
+		"mmap(0x00000000, 0x00002000, 0x00000000, 0x00000000, 0xffffffffffffffff, 0x00000000) = 0x7f2a3c000000",
+	},
+	{
+		syscallinfo.TraceSyscall, 13, 1234, 10*time.Hour + 20*time.Minute + 30*time.Second + 1800*time.Microsecond,
+		"syscall_0x1f4", []string{"0x1", "0x2", "0", "0", "0", "0"}, "-1", "ENOSYS", syscallinfo.StatusFailed, 0,
+		"syscall_0x1f4(0x1, 0x2, 0, 0, 0, 0) = 0xffffffffffffffff",
+	},
+	{
+		syscallinfo.TraceSyscall, 14, 1234, 10*time.Hour + 20*time.Minute + 30*time.Second + 1900*time.Microsecond,
+		"read", []string{"0"}, "", "", syscallinfo.StatusUnfinished, 0,
+		"read(0x00000000, 0x00000000, 0x00000000) = 0x00000000",
+	},
+	{
+		syscallinfo.TraceKilled, 15, 1234, 10*time.Hour + 20*time.Minute + 30*time.Second + 2000*time.Microsecond,
+		"", nil, "", "", 0, 9, "",
+	},
+}
+
+func TestStraceReader(t *testing.T) {
+	r := syscallinfo.NewResolver(linux_amd64.SyscallTable)
+	recs, err := syscallinfo.NewStraceReader(strings.NewReader(straceLog), r).ReadAll()
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	if len(recs) != len(checksStrace) {
+		t.Fatalf("wrong number of records (want=%v, get=%v)", len(checksStrace), len(recs))
+	}
+	for i, check := range checksStrace {
+		rec := recs[i]
+		if rec.Kind != check.kind || rec.Line != check.line || rec.PID != check.pid || rec.Time != check.time {
+			t.Errorf("wrong record (want=%v %v %v %v, get=%v %v %v %v)",
+				check.kind, check.line, check.pid, check.time, rec.Kind, rec.Line, rec.PID, rec.Time)
+			continue
+		}
+		if rec.Signal != check.signal {
+			t.Errorf("line %d: wrong signal (want=%v, get=%v)", check.line, check.signal, rec.Signal)
+		}
+		if rec.Kind != syscallinfo.TraceSyscall {
+			continue
+		}
+		if rec.Syscall.Name != check.name {
+			t.Errorf("line %d: wrong name (want=%v, get=%v)", check.line, check.name, rec.Syscall.Name)
+		}
+		var args []string
+		for _, arg := range rec.Args {
+			args = append(args, arg.Text)
+		}
+		if strings.Join(args, "|") != strings.Join(check.args, "|") {
+			t.Errorf("line %d: wrong args (want=%q, get=%q)", check.line, check.args, args)
+		}
+		if rec.Ret.Text != check.ret || rec.Errno != check.errno || rec.Status != check.status {
+			t.Errorf("line %d: wrong result (want=%v %v %v, get=%v %v %v)",
+				check.line, check.ret, check.errno, check.status, rec.Ret.Text, rec.Errno, rec.Status)
+		}
+		scc, err := rec.Call()
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		if output := scc.String(); output != check.output {
+			t.Errorf("line %d: wrong output (want=%v, get=%v)", check.line, check.output, output)
+		}
+	}
+}
+
+var checksStracePrefix = []struct {
+	line string
+	pid  int
+	time time.Duration
+	dur  time.Duration
+}{
+	{"close(3) = 0", 0, 0, 0},
+	{"[pid  4321] close(3) = 0", 4321, 0, 0},
+	{"4321 close(3) = 0 <0.000005>", 4321, 0, 5 * time.Microsecond},
+	{"10:20:30 close(3) = 0", 0, 10*time.Hour + 20*time.Minute + 30*time.Second, 0},
+	{"1364481363.243456 close(3) = 0", 0, 1364481363*time.Second + 243456*time.Microsecond, 0},
+	{"[pid 7] 1.5 close(3) = 0 <1.25>", 7, 1500 * time.Millisecond, 1250 * time.Millisecond},
+	{"     0.000123 close(3) = 0", 0, 123 * time.Microsecond, 0},
+}
+
+func TestStraceReader_prefix(t *testing.T) {
+	r := syscallinfo.NewResolver(linux_amd64.SyscallTable)
+	for _, check := range checksStracePrefix {
+		rec, err := syscallinfo.NewStraceReader(strings.NewReader(check.line), r).Read()
+		if err != nil {
+			t.Errorf("%v: wrong error (want=nil, get=%v)", check.line, err)
+			continue
+		}
+		if rec.PID != check.pid || rec.Time != check.time || rec.Duration != check.dur {
+			t.Errorf("%v: wrong prefix (want=%v %v %v, get=%v %v %v)",
+				check.line, check.pid, check.time, check.dur, rec.PID, rec.Time, rec.Duration)
+		}
+		if rec.Syscall.Name != "close" || len(rec.Args) != 1 || rec.Args[0].Value != 3 {
+			t.Errorf("%v: wrong call %v", check.line, rec.Syscall.Name)
+		}
+	}
+}
+
+var checksStraceResolve = []struct {
+	line string
+	num  int
+	name string
+}{
+	{"read(3, \"\", 10) = 0", 3, "read"},
+	{"accept(3, NULL, NULL) = 4", 102, "accept"},
+	{"syscall_0x1f4(0, 0, 0, 0, 0, 0) = -1 ENOSYS (Function not implemented)", 500, "syscall_0x1f4"},
+	{"frobnicate(1) = 0", -1, "frobnicate"},
+}
+
+func TestStraceReader_resolve(t *testing.T) {
+	r := syscallinfo.NewResolver(linux_386.SyscallTable4_0)
+	for _, check := range checksStraceResolve {
+		rec, err := syscallinfo.NewStraceReader(strings.NewReader(check.line), r).Read()
+		if err != nil {
+			t.Errorf("%v: wrong error (want=nil, get=%v)", check.line, err)
+			continue
+		}
+		if rec.Syscall.Num != check.num || rec.Syscall.Name != check.name {
+			t.Errorf("wrong syscall (want=%v %v, get=%v %v)", check.num, check.name, rec.Syscall.Num, rec.Syscall.Name)
+		}
+	}
+}
+
+func TestStraceReader_detached(t *testing.T) {
+	const log = "strace: Process 99 attached\n99 read(0, <detached ...>\n"
+	r := syscallinfo.NewResolver(linux_amd64.SyscallTable)
+	sr := syscallinfo.NewStraceReader(strings.NewReader(log), r)
+	rec, err := sr.Read()
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	if rec.Status != syscallinfo.StatusDetached || rec.Line != 2 {
+		t.Errorf("wrong record (want=%v 2, get=%v %v)", syscallinfo.StatusDetached, rec.Status, rec.Line)
+	}
+	if _, err := sr.Read(); err != io.EOF {
+		t.Errorf("wrong error (want=%v, get=%v)", io.EOF, err)
+	}
+}
+
+func TestStraceReader_syntaxError(t *testing.T) {
+	const log = "close(3) = 0\nclose(3 = 0\n"
+	r := syscallinfo.NewResolver(linux_amd64.SyscallTable)
+	_, err := syscallinfo.NewStraceReader(strings.NewReader(log), r).ReadAll()
+	serr, ok := err.(*syscallinfo.TraceSyntaxError)
+	if !ok {
+		t.Fatalf("wrong error (want=*TraceSyntaxError, get=%v)", err)
+	}
+	if serr.Line != 2 {
+		t.Errorf("wrong line (want=2, get=%v)", serr.Line)
+	}
+}
+
+func TestFilter_MatchRecord(t *testing.T) {
+	r := syscallinfo.NewResolver(linux_amd64.SyscallTable)
+	recs, err := syscallinfo.NewStraceReader(strings.NewReader(straceLog), r).ReadAll()
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	f, err := syscallinfo.ParseFilter(r, "trace=%file", "status=failed", "signal=SIGTERM")
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	var lines []int
+	for _, rec := range recs {
+		if f.MatchRecord(rec) {
+			lines = append(lines, rec.Line)
+		}
+	}
+	// The failed openat and the process exits.
+	want := []int{3, 8, 15}
+	if len(lines) != len(want) || lines[0] != want[0] || lines[1] != want[1] || lines[2] != want[2] {
+		t.Errorf("wrong matches (want=%v, get=%v)", want, lines)
+	}
+}
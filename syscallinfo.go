@@ -6,7 +6,6 @@ package syscallinfo
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"strings"
 )
@@ -16,6 +15,15 @@ func init() {
 	Handle(CtxFD, func(n uint64) (string, error) {
 		return fmt.Sprintf("%d", n), nil
 	})
+	Handle(CtxIoctlReq, func(n uint64) (string, error) {
+		return IoctlCode(n).String(), nil
+	})
+	Handle(CtxOpenFlags, func(n uint64) (string, error) {
+		return formatOpenFlags(n), nil
+	})
+	Handle(CtxSignal, func(n uint64) (string, error) {
+		return SignalName(int(n)), nil
+	})
 }
 
 // A Syscall contains information about a syscall in a way that is OS and arch
@@ -36,6 +44,31 @@ type Syscall struct {
 
 	// Args is a slice containing all the syscall's argurments.
 	Args []Argument
+
+	// Categories contains the categories the syscall belongs to.
+	Categories Category
+
+	// Since is the kernel version in which the syscall was added. It is zero
+	// if the syscall predates 2.6.0.
+	Since KernelVersion
+
+	// Until is the kernel version in which the syscall was removed. It is
+	// zero if the syscall has not been removed.
+	Until KernelVersion
+
+	// Status specifies whether the syscall is implemented and its signature
+	// is known.
+	Status SyscallStatus
+}
+
+// Prototype returns the C-like prototype of sc (e.g. "close(unsigned int
+// fd)").
+func (sc Syscall) Prototype() string {
+	sigs := make([]string, len(sc.Args))
+	for i, arg := range sc.Args {
+		sigs[i] = arg.Sig
+	}
+	return fmt.Sprintf("%s(%s)", sc.Name, strings.Join(sigs, ", "))
 }
 
 // Argument represents a syscall argument.
@@ -59,30 +92,216 @@ const (
 	CtxNone Context = iota
 	// CtxFD represents a File descriptor.
 	CtxFD
+	// CtxIoctlReq represents an ioctl request code.
+	CtxIoctlReq
+	// CtxOpenFlags represents the flags of open (O_*).
+	CtxOpenFlags
+	// CtxSignal represents a signal number.
+	CtxSignal
 )
 
-// UnmarshalJSON implements JSON unmarshaling for context.
+// contextNames contains the names used for each context in the JSON syscall
+// tables. CtxNone is represented by an empty string.
+var contextNames = map[Context]string{
+	CtxNone:      "",
+	CtxFD:        "FD",
+	CtxIoctlReq:  "IOCTL_REQ",
+	CtxOpenFlags: "OPEN_FLAGS",
+	CtxSignal:    "SIGNAL",
+}
+
+// contextIdents contains the Go identifiers of the contexts. They are used
+// by mksyscalltable.go.
+var contextIdents = map[Context]string{
+	CtxNone:      "CtxNone",
+	CtxFD:        "CtxFD",
+	CtxIoctlReq:  "CtxIoctlReq",
+	CtxOpenFlags: "CtxOpenFlags",
+	CtxSignal:    "CtxSignal",
+}
+
+// ParseContext returns the context with the given name (e.g. "FD"). The
+// empty string represents CtxNone.
+func ParseContext(name string) (Context, error) {
+	for ctx, cn := range contextNames {
+		if cn == name {
+			return ctx, nil
+		}
+	}
+	return CtxNone, &ParseError{Kind: "context", Value: name}
+}
+
+// String returns the name of ctx used in the JSON syscall tables (e.g.
+// "FD"). CtxNone is represented by an empty string.
+func (ctx Context) String() string {
+	if name, ok := contextNames[ctx]; ok {
+		return name
+	}
+	return fmt.Sprintf("Context(%d)", int(ctx))
+}
+
+// GoString returns the Go syntax representation of ctx. It is used by
+// mksyscalltable.go.
+func (ctx Context) GoString() string {
+	if ident, ok := contextIdents[ctx]; ok {
+		return "syscallinfo." + ident
+	}
+	return fmt.Sprintf("syscallinfo.Context(%d)", int(ctx))
+}
+
+// MarshalText implements text marshaling for context. It fails if ctx is
+// not a known context.
+func (ctx Context) MarshalText() ([]byte, error) {
+	name, ok := contextNames[ctx]
+	if !ok {
+		return nil, &ParseError{Kind: "context", Value: ctx.String()}
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText implements text unmarshaling for context. Unknown context
+// names are rejected.
+func (ctx *Context) UnmarshalText(text []byte) error {
+	c, err := ParseContext(string(text))
+	if err != nil {
+		return err
+	}
+	*ctx = c
+	return nil
+}
+
+// MarshalJSON implements JSON marshaling for context.
+func (ctx Context) MarshalJSON() ([]byte, error) {
+	text, err := ctx.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements JSON unmarshaling for context. Unknown context
+// names are rejected.
 func (ctx *Context) UnmarshalJSON(data []byte) error {
 	var s string
 	if err := json.Unmarshal(data, &s); err != nil {
-		return fmt.Errorf("context should be a string, got %s", data)
+		return &ParseError{Kind: "context", Value: string(data)}
+	}
+	return ctx.UnmarshalText([]byte(s))
+}
+
+// SyscallStatus specifies the state of a syscall number in a table.
+type SyscallStatus int
+
+const (
+	// SyscallOK means that the syscall is implemented and its signature is
+	// known.
+	SyscallOK SyscallStatus = iota
+	// SyscallUnknownSignature means that the syscall is implemented but its
+	// signature could not be found, so Args is empty.
+	SyscallUnknownSignature
+	// SyscallNotImplemented means that the number is reserved but the
+	// syscall is not implemented (sys_ni_syscall).
+	SyscallNotImplemented
+	// SyscallUnknownNumber means that the number is not present in the
+	// table. It is only used by the syscalls returned by UnknownSyscall.
+	SyscallUnknownNumber
+)
+
+// syscallStatusNames contains the names used for each syscall status in the
+// JSON syscall tables. SyscallOK is represented by an empty string.
+var syscallStatusNames = map[SyscallStatus]string{
+	SyscallOK:               "",
+	SyscallUnknownSignature: "UNKNOWN_SIGNATURE",
+	SyscallNotImplemented:   "NOT_IMPLEMENTED",
+	SyscallUnknownNumber:    "UNKNOWN_NUMBER",
+}
+
+// syscallStatusIdents contains the Go identifiers of the syscall statuses.
+// They are used by mksyscalltable.go.
+var syscallStatusIdents = map[SyscallStatus]string{
+	SyscallOK:               "SyscallOK",
+	SyscallUnknownSignature: "SyscallUnknownSignature",
+	SyscallNotImplemented:   "SyscallNotImplemented",
+	SyscallUnknownNumber:    "SyscallUnknownNumber",
+}
+
+// ParseSyscallStatus returns the syscall status with the given name (e.g.
+// "NOT_IMPLEMENTED"). The empty string represents SyscallOK.
+func ParseSyscallStatus(name string) (SyscallStatus, error) {
+	for st, sn := range syscallStatusNames {
+		if sn == name {
+			return st, nil
+		}
+	}
+	return SyscallOK, &ParseError{Kind: "status", Value: name}
+}
+
+// String returns the name of st used in the JSON syscall tables (e.g.
+// "NOT_IMPLEMENTED"). SyscallOK is represented by an empty string.
+func (st SyscallStatus) String() string {
+	if name, ok := syscallStatusNames[st]; ok {
+		return name
 	}
-	switch s {
-	case "FD":
-		*ctx = CtxFD
-	default:
-		*ctx = CtxNone
+	return fmt.Sprintf("SyscallStatus(%d)", int(st))
+}
+
+// GoString returns the Go syntax representation of st. It is used by
+// mksyscalltable.go.
+func (st SyscallStatus) GoString() string {
+	if ident, ok := syscallStatusIdents[st]; ok {
+		return "syscallinfo." + ident
 	}
+	return fmt.Sprintf("syscallinfo.SyscallStatus(%d)", int(st))
+}
+
+// MarshalText implements text marshaling for syscall status. It fails if st
+// is not a known status.
+func (st SyscallStatus) MarshalText() ([]byte, error) {
+	name, ok := syscallStatusNames[st]
+	if !ok {
+		return nil, &ParseError{Kind: "status", Value: st.String()}
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText implements text unmarshaling for syscall status. Unknown
+// status names are rejected.
+func (st *SyscallStatus) UnmarshalText(text []byte) error {
+	s, err := ParseSyscallStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*st = s
 	return nil
 }
 
+// MarshalJSON implements JSON marshaling for syscall status.
+func (st SyscallStatus) MarshalJSON() ([]byte, error) {
+	text, err := st.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements JSON unmarshaling for syscall status. Unknown
+// status names are rejected.
+func (st *SyscallStatus) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return &ParseError{Kind: "status", Value: string(data)}
+	}
+	return st.UnmarshalText([]byte(s))
+}
+
 // A SyscallTable contains the information about the syscalls of a specific
 // OS.
 type SyscallTable map[int]Syscall
 
 // A Resolver allows to access information from a given syscall table.
 type Resolver struct {
-	tbl SyscallTable
+	tbl  SyscallTable
+	arch string
 }
 
 // NewResolver returns a syscall resolver for the specified syscall table.
@@ -90,13 +309,22 @@ func NewResolver(tbl SyscallTable) Resolver {
 	return Resolver{tbl: tbl}
 }
 
+// wordSize returns the word size of the arch of r, or zero if the arch is
+// unknown.
+func (r Resolver) wordSize() int {
+	if r.arch == "" {
+		return 0
+	}
+	return WordSize(r.arch)
+}
+
 // SyscallN returns a Syscall object which number matches the provided one.
 func (r Resolver) SyscallN(n int) (Syscall, error) {
 	sc, ok := r.tbl[n]
 	if ok {
 		return sc, nil
 	}
-	return Syscall{}, errors.New("unknown syscall")
+	return Syscall{}, &UnknownSyscallError{Num: n, Arch: r.arch}
 }
 
 // SyscallEntry returns a Syscall object which entry point matches the provided
@@ -107,7 +335,54 @@ func (r Resolver) SyscallEntry(entry string) (Syscall, error) {
 			return sc, nil
 		}
 	}
-	return Syscall{}, errors.New("unknown syscall")
+	return Syscall{}, &UnknownSyscallError{Entry: entry, Arch: r.arch}
+}
+
+// SyscallName returns a Syscall object which name matches the provided one.
+// If several syscalls share the same name (e.g. x32 syscalls in amd64), the
+// one with the lowest number is returned.
+func (r Resolver) SyscallName(name string) (Syscall, error) {
+	found := false
+	var ret Syscall
+	for _, sc := range r.tbl {
+		if sc.Name != name {
+			continue
+		}
+		if !found || sc.Num < ret.Num {
+			ret = sc
+			found = true
+		}
+	}
+	if found {
+		return ret, nil
+	}
+	return Syscall{}, &UnknownSyscallError{Name: name, Arch: r.arch}
+}
+
+// UnknownSyscall returns a placeholder for the syscall number n when it is
+// not present in the table, so calls to it can still be represented. As in
+// strace, it is named "syscall_0x<n>" and takes the six raw registers as
+// arguments, which are printed as hex numbers.
+func UnknownSyscall(n int) Syscall {
+	sc := Syscall{
+		Num:    n,
+		Name:   fmt.Sprintf("syscall_%#x", n),
+		Status: SyscallUnknownNumber,
+	}
+	for i := 0; i < MaxArgs; i++ {
+		sc.Args = append(sc.Args, Argument{Sig: fmt.Sprintf("unsigned long arg%d", i)})
+	}
+	return sc
+}
+
+// SyscallNOrUnknown returns the syscall which number matches the provided
+// one or, if it is not present in the table, the placeholder returned by
+// UnknownSyscall.
+func (r Resolver) SyscallNOrUnknown(n int) Syscall {
+	if sc, ok := r.tbl[n]; ok {
+		return sc
+	}
+	return UnknownSyscall(n)
 }
 
 // HandlerFunc is a function that implements how a value must be
@@ -134,10 +409,11 @@ func Handle(ctx Context, h HandlerFunc) {
 // A SyscallCall represents a call to a syscall, with its own return value,
 // arguments and context handler.
 type SyscallCall struct {
-	sc   Syscall
-	ret  uint64
-	args []uint64
-	ch   ContextHandler
+	sc       Syscall
+	ret      uint64
+	args     []uint64
+	ch       ContextHandler
+	wordSize int
 }
 
 // NewSyscallCall returns a reference to a new SyscallCall object. The number
@@ -145,13 +421,14 @@ type SyscallCall struct {
 // required by the syscall.
 func NewSyscallCall(sc Syscall, ret uint64, args ...uint64) (*SyscallCall, error) {
 	if len(args) < len(sc.Args) {
-		return nil, errors.New("invalid number of arguments")
+		return nil, &ArgCountError{Want: len(sc.Args), Got: len(args)}
 	}
 	scc := &SyscallCall{
-		sc:   sc,
-		args: args,
-		ret:  ret,
-		ch:   DefaultContextHandler,
+		sc:       sc,
+		args:     args,
+		ret:      ret,
+		ch:       DefaultContextHandler,
+		wordSize: 64,
 	}
 	return scc, nil
 }
@@ -170,12 +447,23 @@ const (
 	OutRet OutputOption = 1 << iota
 )
 
-// Output returns a string with the representation of the call.
+// Output returns a string with the representation of the call. If the
+// syscall is command-multiplexed and the command is known, the arguments
+// that follow the command are represented using its sub-signature.
 func (scc *SyscallCall) Output(opts OutputOption) (string, error) {
 	str := ""
 	argsStr := ""
-	for i := range scc.sc.Args {
-		argStr, err := scc.handleContext(scc.args[i], scc.sc.Args[i].Context)
+	args, retCtx, cs, cmd := scc.signature()
+	for i := range args {
+		var argStr string
+		var err error
+		if scc.sc.Status == SyscallUnknownNumber {
+			argStr = formatHex(scc.args[i])
+		} else if cs != nil && i == cs.Index {
+			argStr = cs.format(cmd, scc.args[i])
+		} else {
+			argStr, err = scc.handleContext(scc.args[i], args[i].Context)
+		}
 		if err != nil {
 			return "", err
 		}
@@ -184,7 +472,7 @@ func (scc *SyscallCall) Output(opts OutputOption) (string, error) {
 	argsStr = strings.TrimSuffix(argsStr, ", ")
 	str += fmt.Sprintf("%s(%s)", scc.sc.Name, argsStr)
 	if opts&OutRet != 0 {
-		retStr, err := scc.handleContext(scc.ret, scc.sc.Context)
+		retStr, err := scc.handleContext(scc.ret, retCtx)
 		if err != nil {
 			return "", err
 		}
@@ -193,6 +481,15 @@ func (scc *SyscallCall) Output(opts OutputOption) (string, error) {
 	return str, nil
 }
 
+// formatHex returns the hex representation of n as printed by strace, which
+// omits the prefix for zero.
+func formatHex(n uint64) string {
+	if n == 0 {
+		return "0"
+	}
+	return fmt.Sprintf("%#x", n)
+}
+
 // String returns a string with the representation of the call plus the return
 // value. An empty string is returned on error.
 func (scc *SyscallCall) String() string {
@@ -208,6 +505,38 @@ func (scc *SyscallCall) Syscall() Syscall {
 	return scc.sc
 }
 
+// Failed reports whether the call returned an error. That is, if its return
+// value, interpreted as a signed integer of the word size of the call, is in
+// the range [-4095, -1].
+func (scc *SyscallCall) Failed() bool {
+	n := int64(scc.ret)
+	if scc.wordSize == 32 {
+		n = int64(int32(scc.ret))
+	}
+	return n >= -4095 && n <= -1
+}
+
+// SetWordSize sets the word size in bits (32 or 64) of the arch of the call,
+// which determines how its return value is interpreted. By default, it is
+// 64. The word size of an arch is returned by WordSize.
+func (scc *SyscallCall) SetWordSize(bits int) {
+	scc.wordSize = bits
+}
+
+// wordSizes contains the word size of the archs that are not 64-bit.
+var wordSizes = map[string]int{
+	"linux_386": 32,
+	"linux_arm": 32,
+}
+
+// WordSize returns the word size in bits of the provided arch.
+func WordSize(arch string) int {
+	if bits, ok := wordSizes[arch]; ok {
+		return bits
+	}
+	return 64
+}
+
 // handleContext returns a string with the contextualized representation of the
 // provided value.
 func (scc *SyscallCall) handleContext(n uint64, ctx Context) (string, error) {
@@ -208,3 +208,22 @@ func TestContextHandler_Handle(t *testing.T) {
 		t.Errorf("wrong string (want=%v, get=%v)", checkHandle.outputCall, str)
 	}
 }
+
+func TestUnknownSyscall(t *testing.T) {
+	r := syscallinfo.NewResolver(linux_386.SyscallTable)
+	sc := r.SyscallNOrUnknown(0x1f4)
+	if sc.Status != syscallinfo.SyscallUnknownNumber {
+		t.Errorf("wrong status (want=%#v, get=%#v)", syscallinfo.SyscallUnknownNumber, sc.Status)
+	}
+	scc, err := syscallinfo.NewSyscallCall(sc, 0xffffffda, 1, 2, 3, 0x4000, 5, 0)
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	want := "syscall_0x1f4(0x1, 0x2, 0x3, 0x4000, 0x5, 0) = 0xffffffda"
+	if get := scc.String(); get != want {
+		t.Errorf("wrong output (want=%v, get=%v)", want, get)
+	}
+	if sc := r.SyscallNOrUnknown(3); sc.Name != "read" {
+		t.Errorf("wrong name (want=read, get=%v)", sc.Name)
+	}
+}
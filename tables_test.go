@@ -0,0 +1,173 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo_test
+
+import (
+	"testing"
+
+	"github.com/jroimartin/syscallinfo"
+	"github.com/jroimartin/syscallinfo/linux_386"
+	"github.com/jroimartin/syscallinfo/linux_amd64"
+	"github.com/jroimartin/syscallinfo/linux_arm"
+	"github.com/jroimartin/syscallinfo/linux_arm64"
+	"github.com/jroimartin/syscallinfo/linux_riscv64"
+)
+
+var checksNewSyscalls = []struct {
+	tbl   syscallinfo.SyscallTable
+	name  string
+	num   int
+	since string
+}{
+	{linux_amd64.SyscallTable, "statx", 332, "4.11"},
+	{linux_amd64.SyscallTable, "rseq", 334, "4.18"},
+	{linux_amd64.SyscallTable, "uretprobe", 335, "6.11"},
+	{linux_amd64.SyscallTable, "io_uring_setup", 425, "5.1"},
+	{linux_amd64.SyscallTable, "io_uring_enter", 426, "5.1"},
+	{linux_amd64.SyscallTable, "pidfd_open", 434, "5.3"},
+	{linux_amd64.SyscallTable, "clone3", 435, "5.3"},
+	{linux_amd64.SyscallTable, "close_range", 436, "5.9"},
+	{linux_amd64.SyscallTable, "openat2", 437, "5.6"},
+	{linux_amd64.SyscallTable, "faccessat2", 439, "5.8"},
+	{linux_amd64.SyscallTable, "process_madvise", 440, "5.10"},
+	{linux_amd64.SyscallTable, "epoll_pwait2", 441, "5.11"},
+	{linux_amd64.SyscallTable, "landlock_create_ruleset", 444, "5.13"},
+	{linux_amd64.SyscallTable, "memfd_secret", 447, "5.14"},
+	{linux_amd64.SyscallTable, "futex_waitv", 449, "5.16"},
+	{linux_amd64.SyscallTable, "set_mempolicy_home_node", 450, "5.17"},
+	{linux_amd64.SyscallTable, "cachestat", 451, "6.5"},
+	{linux_amd64.SyscallTable, "fchmodat2", 452, "6.6"},
+	{linux_amd64.SyscallTable, "map_shadow_stack", 453, "6.6"},
+	{linux_amd64.SyscallTable, "futex_wake", 454, "6.7"},
+	{linux_amd64.SyscallTable, "futex_wait", 455, "6.7"},
+	{linux_amd64.SyscallTable, "futex_requeue", 456, "6.7"},
+	{linux_amd64.SyscallTable, "statmount", 457, "6.8"},
+	{linux_amd64.SyscallTable, "listmount", 458, "6.8"},
+	{linux_amd64.SyscallTable, "lsm_get_self_attr", 459, "6.8"},
+	{linux_amd64.SyscallTable, "lsm_set_self_attr", 460, "6.8"},
+	{linux_amd64.SyscallTable, "lsm_list_modules", 461, "6.8"},
+	{linux_amd64.SyscallTable, "mseal", 462, "6.10"},
+	{linux_amd64.SyscallTable, "setxattrat", 463, "6.13"},
+	{linux_386.SyscallTable, "socket", 359, "4.3"},
+	{linux_386.SyscallTable, "statx", 383, "4.11"},
+	{linux_386.SyscallTable, "clock_gettime64", 403, "5.1"},
+	{linux_386.SyscallTable, "io_uring_setup", 425, "5.1"},
+	{linux_386.SyscallTable, "pidfd_open", 434, "5.3"},
+	{linux_386.SyscallTable, "futex_waitv", 449, "5.16"},
+	{linux_386.SyscallTable, "mseal", 462, "6.10"},
+	{linux_386.SyscallTable, "setxattrat", 463, "6.13"},
+	{linux_arm.SyscallTable, "pidfd_open", 434, "5.3"},
+	{linux_arm.SyscallTable, "clock_gettime64", 403, "5.1"},
+	{linux_arm.SyscallTable, "open_tree_attr", 467, "6.15"},
+	{linux_arm64.SyscallTable, "clone3", 435, "5.3"},
+	{linux_arm64.SyscallTable, "memfd_secret", 447, "5.14"},
+	{linux_arm64.SyscallTable, "open_tree_attr", 467, "6.15"},
+	{linux_riscv64.SyscallTable, "riscv_hwprobe", 258, "6.4"},
+	{linux_riscv64.SyscallTable, "riscv_flush_icache", 259, "4.15"},
+	{linux_riscv64.SyscallTable, "mseal", 462, "6.10"},
+}
+
+func TestTables_newSyscalls(t *testing.T) {
+	for _, check := range checksNewSyscalls {
+		r := syscallinfo.NewResolver(check.tbl)
+		sc, err := r.SyscallName(check.name)
+		if err != nil {
+			t.Errorf("wrong error for %v (want=nil, get=%v)", check.name, err)
+			continue
+		}
+		if sc.Num != check.num {
+			t.Errorf("wrong number for %v (want=%v, get=%v)", check.name, check.num, sc.Num)
+		}
+		if sc.Since.String() != check.since {
+			t.Errorf("wrong since for %v (want=%v, get=%v)", check.name, check.since, sc.Since)
+		}
+	}
+}
+
+func TestTables_snapshot(t *testing.T) {
+	r := syscallinfo.NewResolver(linux_amd64.SyscallTable4_0)
+	if _, err := r.SyscallName("statx"); err == nil {
+		t.Errorf("statx should not be present in the 4.0 snapshot")
+	}
+	if _, err := r.SyscallName("execveat"); err != nil {
+		t.Errorf("wrong error (want=nil, get=%v)", err)
+	}
+}
+
+var checksStatus = []struct {
+	tbl    syscallinfo.SyscallTable
+	num    int
+	name   string
+	status syscallinfo.SyscallStatus
+}{
+	{linux_386.SyscallTable, 17, "break", syscallinfo.SyscallNotImplemented},
+	{linux_386.SyscallTable, 169, "nfsservctl", syscallinfo.SyscallNotImplemented},
+	{linux_386.SyscallTable, 149, "_sysctl", syscallinfo.SyscallNotImplemented},
+	{linux_386.SyscallTable, 253, "lookup_dcookie", syscallinfo.SyscallNotImplemented},
+	{linux_amd64.SyscallTable, 184, "tuxcall", syscallinfo.SyscallNotImplemented},
+	{linux_amd64.SyscallTable, 156, "_sysctl", syscallinfo.SyscallNotImplemented},
+	{linux_amd64.SyscallTable, 212, "lookup_dcookie", syscallinfo.SyscallNotImplemented},
+	{linux_amd64.SyscallTable, 335, "uretprobe", syscallinfo.SyscallOK},
+	{linux_amd64.SyscallTable, 513, "rt_sigreturn", syscallinfo.SyscallUnknownSignature},
+	{linux_amd64.SyscallTable, 520, "execve", syscallinfo.SyscallOK},
+	{linux_amd64.SyscallTable, 0, "read", syscallinfo.SyscallOK},
+	{linux_arm.SyscallTable, 149, "_sysctl", syscallinfo.SyscallNotImplemented},
+	{linux_arm.SyscallTable, 313, "vserver", syscallinfo.SyscallNotImplemented},
+	{linux_arm.SyscallTable, 119, "sigreturn", syscallinfo.SyscallUnknownSignature},
+	{linux_arm.SyscallTable, 270, "arm_fadvise64_64", syscallinfo.SyscallOK},
+	{linux_arm64.SyscallTable, 18, "lookup_dcookie", syscallinfo.SyscallNotImplemented},
+	{linux_arm64.SyscallTable, 42, "nfsservctl", syscallinfo.SyscallNotImplemented},
+	{linux_arm64.SyscallTable, 38, "renameat", syscallinfo.SyscallOK},
+	{linux_riscv64.SyscallTable, 79, "newfstatat", syscallinfo.SyscallOK},
+}
+
+func TestTables_status(t *testing.T) {
+	for _, check := range checksStatus {
+		r := syscallinfo.NewResolver(check.tbl)
+		sc, err := r.SyscallN(check.num)
+		if err != nil {
+			t.Errorf("wrong error for %v (want=nil, get=%v)", check.num, err)
+			continue
+		}
+		if sc.Name != check.name {
+			t.Errorf("wrong name (want=%v, get=%v)", check.name, sc.Name)
+		}
+		if sc.Status != check.status {
+			t.Errorf("wrong status for %v (want=%#v, get=%#v)", check.name, check.status, sc.Status)
+		}
+	}
+}
+
+var checksSnapshotStatus = []struct {
+	tbl    syscallinfo.SyscallTable
+	num    int
+	name   string
+	status syscallinfo.SyscallStatus
+}{
+	{linux_386.SyscallTable4_0, 17, "break", syscallinfo.SyscallNotImplemented},
+	{linux_386.SyscallTable4_0, 273, "vserver", syscallinfo.SyscallNotImplemented},
+	{linux_amd64.SyscallTable4_0, 185, "security", syscallinfo.SyscallNotImplemented},
+	{linux_amd64.SyscallTable4_0, 520, "execve", syscallinfo.SyscallUnknownSignature},
+	{linux_amd64.SyscallTable4_0, 545, "execveat", syscallinfo.SyscallUnknownSignature},
+}
+
+func TestTables_snapshotStatus(t *testing.T) {
+	for _, check := range checksSnapshotStatus {
+		sc, err := syscallinfo.NewResolver(check.tbl).SyscallN(check.num)
+		if err != nil {
+			t.Errorf("wrong error for %v (want=nil, get=%v)", check.num, err)
+			continue
+		}
+		if sc.Name != check.name || sc.Status != check.status {
+			t.Errorf("wrong syscall (want=%v %#v, get=%v %#v)", check.name, check.status, sc.Name, sc.Status)
+		}
+	}
+	d := syscallinfo.DiffTables(linux_386.SyscallTable4_0, linux_386.SyscallTable)
+	for _, sc := range d.Added {
+		if sc.Name == "break" || sc.Name == "afs_syscall" {
+			t.Errorf("%v reported as added", sc.Name)
+		}
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TraceKind identifies the kind of event described by a TraceRecord.
+type TraceKind int
+
+const (
+	// TraceSyscall is a syscall call.
+	TraceSyscall TraceKind = iota
+	// TraceSignal is the delivery of a signal.
+	TraceSignal
+	// TraceExit is the exit of a process.
+	TraceExit
+	// TraceKilled means that a process was killed by a signal.
+	TraceKilled
+)
+
+// A TraceValue is an argument or return value read from the log of a
+// syscall tracer.
+type TraceValue struct {
+	// Text is the value as printed by the tracer (e.g. "O_RDONLY|O_CLOEXEC"
+	// or "\"/etc/passwd\"").
+	Text string
+
+	// Value is the numeric value. It is only valid if Numeric is true.
+	Value uint64
+
+	// Numeric reports whether Value could be reconstructed from Text.
+	// Strings, structures and arrays are not reconstructed.
+	Numeric bool
+}
+
+// A TraceRecord is an event read from the log of a syscall tracer.
+type TraceRecord struct {
+	// Kind is the kind of event.
+	Kind TraceKind
+
+	// Line is the line of the log where the record ends.
+	Line int
+
+	// PID is the process (or thread) ID. It is zero if it is not logged.
+	PID int
+
+	// Time is the timestamp of the event. Its origin depends on the tracer
+	// and its options (e.g. midnight for strace -tt and the Unix epoch for
+	// strace -ttt). It is zero if it is not logged.
+	Time time.Duration
+
+	// Duration is the time spent in the syscall (strace -T). It is zero if
+	// it is not logged.
+	Duration time.Duration
+
+	// Syscall is the called syscall, resolved by name against the table of
+	// the reader. If the name is not found, only Name is set, Num is -1 and
+	// Status is SyscallUnknownNumber.
+	Syscall Syscall
+
+	// Args are the arguments of the call.
+	Args []TraceValue
+
+	// Ret is the return value of the call. It is empty if the call did not
+	// return.
+	Ret TraceValue
+
+	// Errno is the name of the error returned by the call (e.g. "ENOENT").
+	Errno string
+
+	// Status is the outcome of the call.
+	Status CallStatus
+
+	// Signal is the delivered signal (TraceSignal) or the signal that
+	// killed the process (TraceKilled).
+	Signal int
+
+	// ExitCode is the exit code of the process (TraceExit).
+	ExitCode int
+
+	// Info is additional information printed by the tracer, such as the
+	// siginfo of a signal or the decoded return value of a call.
+	Info string
+
+	// wordSize is the word size of the arch of the call. It is zero if the
+	// arch is unknown.
+	wordSize int
+}
+
+// A TraceReader reads the records of a syscall tracer log. Read returns
+// io.EOF when there are no more records.
+type TraceReader interface {
+	Read() (*TraceRecord, error)
+}
+
+// ReadAllRecords reads all the remaining records from tr.
+func ReadAllRecords(tr TraceReader) ([]*TraceRecord, error) {
+	var recs []*TraceRecord
+	for {
+		rec, err := tr.Read()
+		if err == io.EOF {
+			return recs, nil
+		}
+		if err != nil {
+			return recs, err
+		}
+		recs = append(recs, rec)
+	}
+}
+
+// Call returns the SyscallCall that corresponds to rec, which must be a
+// TraceSyscall record. Arguments that could not be reconstructed
+// numerically are set to zero, so its output may differ from the one of the
+// tracer. If the arch of the reader is known, the word size of the call is
+// set accordingly and the values are truncated to it.
+func (rec *TraceRecord) Call() (*SyscallCall, error) {
+	if rec.Kind != TraceSyscall {
+		return nil, ErrNotSyscall
+	}
+	n := len(rec.Args)
+	if n < len(rec.Syscall.Args) {
+		n = len(rec.Syscall.Args)
+	}
+	mask := ^uint64(0)
+	if rec.wordSize == 32 {
+		mask = 1<<32 - 1
+	}
+	args := make([]uint64, n)
+	for i, arg := range rec.Args {
+		args[i] = arg.Value & mask
+	}
+	scc, err := NewSyscallCall(rec.Syscall, rec.Ret.Value&mask, args...)
+	if err != nil {
+		return nil, err
+	}
+	if rec.wordSize != 0 {
+		scc.SetWordSize(rec.wordSize)
+	}
+	return scc, nil
+}
+
+// resolveName returns the syscall with the given name in the table of r.
+// Names with the form "syscall_0x1f4", used for unknown numbers, are
+// resolved by number. Multiplexed syscalls (e.g. "accept" on linux_386) are
+// resolved to their sub-call and take the number of the multiplexer.
+func resolveName(r Resolver, name string) Syscall {
+	if sc, err := r.SyscallName(name); err == nil {
+		return sc
+	}
+	if s := strings.TrimPrefix(name, "syscall_"); s != name {
+		if n, err := strconv.ParseUint(s, 0, 32); err == nil {
+			return r.SyscallNOrUnknown(int(n))
+		}
+	}
+	if mux, call, ok := subcall(name); ok {
+		if muxsc, err := r.SyscallName(mux); err == nil {
+			sc := multiplexers[mux][call]
+			sc.Num = muxsc.Num
+			return sc
+		}
+	}
+	return Syscall{Num: -1, Name: name, Status: SyscallUnknownNumber}
+}
+
+// parseTraceValue reconstructs the numeric value of an argument or return
+// value printed by a tracer. Besides integers, it understands NULL,
+// AT_FDCWD, signal names and open flags.
+func parseTraceValue(text string) TraceValue {
+	tv := TraceValue{Text: text}
+	s := text
+	if i := strings.Index(s, " /*"); i >= 0 {
+		s = s[:i]
+	}
+	// strace -y prints file descriptors as "3</etc/passwd>".
+	if i := strings.Index(s, "<"); i > 0 && strings.HasSuffix(s, ">") {
+		s = s[:i]
+	}
+	var err error
+	switch {
+	case s == "NULL":
+		tv.Value = 0
+	case s == "AT_FDCWD":
+		tv.Value = atFDCWD
+	case strings.HasPrefix(s, "SIG"):
+		var sig int
+		sig, err = ParseSignal(s)
+		tv.Value = uint64(sig)
+	case strings.HasPrefix(s, "O_"):
+		tv.Value, err = parseOpenFlags(s)
+	default:
+		tv.Value, err = parseInt(s)
+	}
+	tv.Numeric = err == nil
+	if !tv.Numeric {
+		tv.Value = 0
+	}
+	return tv
+}
+
+// atFDCWD is the special file descriptor (-100) used by the *at syscalls to
+// refer to the current working directory.
+const atFDCWD = ^uint64(99)
+
+// parseInt parses a signed or unsigned integer in C syntax (e.g. "-1",
+// "0x7f12" or "0644").
+func parseInt(s string) (uint64, error) {
+	if n, err := strconv.ParseInt(s, 0, 64); err == nil {
+		return uint64(n), nil
+	}
+	return strconv.ParseUint(s, 0, 64)
+}
+
+// parseSeconds returns the duration represented by an integer number of
+// seconds and its decimal part.
+func parseSeconds(secs, frac string) time.Duration {
+	s, _ := strconv.ParseInt(secs, 10, 64)
+	d := time.Duration(s) * time.Second
+	if frac != "" {
+		if len(frac) > 9 {
+			frac = frac[:9]
+		}
+		ns, _ := strconv.Atoi(frac + strings.Repeat("0", 9-len(frac)))
+		d += time.Duration(ns)
+	}
+	return d
+}
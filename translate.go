@@ -0,0 +1,225 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo
+
+import "sort"
+
+// renames lists syscalls that are equivalent across architectures although
+// their names differ. The first name of each pair is the one used by 32-bit
+// architectures, which usually keep a legacy syscall under the second name.
+var renames = [][2]string{
+	{"_llseek", "lseek"},
+	{"mmap2", "mmap"},
+	{"fstatat64", "newfstatat"},
+	{"_newselect", "select"},
+	{"stat64", "stat"},
+	{"lstat64", "lstat"},
+	{"fstat64", "fstat"},
+	{"statfs64", "statfs"},
+	{"fstatfs64", "fstatfs"},
+	{"fcntl64", "fcntl"},
+	{"truncate64", "truncate"},
+	{"ftruncate64", "ftruncate"},
+	{"sendfile64", "sendfile"},
+	{"fadvise64_64", "fadvise64"},
+	{"ugetrlimit", "getrlimit"},
+	{"send", "sendto"},
+	{"recv", "recvfrom"},
+	{"chown32", "chown"},
+	{"lchown32", "lchown"},
+	{"fchown32", "fchown"},
+	{"getuid32", "getuid"},
+	{"getgid32", "getgid"},
+	{"geteuid32", "geteuid"},
+	{"getegid32", "getegid"},
+	{"setuid32", "setuid"},
+	{"setgid32", "setgid"},
+	{"setreuid32", "setreuid"},
+	{"setregid32", "setregid"},
+	{"setresuid32", "setresuid"},
+	{"getresuid32", "getresuid"},
+	{"setresgid32", "setresgid"},
+	{"getresgid32", "getresgid"},
+	{"setfsuid32", "setfsuid"},
+	{"setfsgid32", "setfsgid"},
+	{"getgroups32", "getgroups"},
+	{"setgroups32", "setgroups"},
+	{"clock_gettime64", "clock_gettime"},
+	{"clock_settime64", "clock_settime"},
+	{"clock_adjtime64", "clock_adjtime"},
+	{"clock_getres_time64", "clock_getres"},
+	{"clock_nanosleep_time64", "clock_nanosleep"},
+	{"timer_gettime64", "timer_gettime"},
+	{"timer_settime64", "timer_settime"},
+	{"timerfd_gettime64", "timerfd_gettime"},
+	{"timerfd_settime64", "timerfd_settime"},
+	{"utimensat_time64", "utimensat"},
+	{"pselect6_time64", "pselect6"},
+	{"ppoll_time64", "ppoll"},
+	{"io_pgetevents_time64", "io_pgetevents"},
+	{"recvmmsg_time64", "recvmmsg"},
+	{"mq_timedsend_time64", "mq_timedsend"},
+	{"mq_timedreceive_time64", "mq_timedreceive"},
+	{"semtimedop_time64", "semtimedop"},
+	{"rt_sigtimedwait_time64", "rt_sigtimedwait"},
+	{"futex_time64", "futex"},
+	{"sched_rr_get_interval_time64", "sched_rr_get_interval"},
+}
+
+// A Translation is the result of translating a syscall to a different
+// syscall table.
+type Translation struct {
+	// Syscall is the equivalent syscall in the target table.
+	Syscall Syscall
+
+	// Call is the sub-call number that must be passed to Syscall when it is
+	// a multiplexer (e.g. socketcall or ipc). Otherwise, it is -1.
+	Call int
+}
+
+// A Translator maps syscalls between two syscall tables, usually from
+// different architectures.
+type Translator struct {
+	from Resolver
+	to   Resolver
+}
+
+// NewTranslator returns a Translator that maps syscalls from the table from
+// to the table to.
+func NewTranslator(from, to SyscallTable) *Translator {
+	return &Translator{from: NewResolver(from), to: NewResolver(to)}
+}
+
+// NewArchTranslator returns a Translator that maps syscalls between two
+// registered archs.
+func NewArchTranslator(from, to string) (*Translator, error) {
+	fromTbl, err := Table(from)
+	if err != nil {
+		return nil, err
+	}
+	toTbl, err := Table(to)
+	if err != nil {
+		return nil, err
+	}
+	t := &Translator{
+		from: Resolver{tbl: fromTbl, arch: from},
+		to:   Resolver{tbl: toTbl, arch: to},
+	}
+	return t, nil
+}
+
+// Translate returns the translation of the syscall number n. If n is a
+// multiplexer, TranslateSubcall must be used instead.
+func (t *Translator) Translate(n int) (Translation, error) {
+	sc, err := t.from.SyscallN(n)
+	if err != nil {
+		return Translation{}, err
+	}
+	if _, ok := multiplexers[sc.Name]; ok {
+		return Translation{}, &SubcallRequiredError{Syscall: sc.Name}
+	}
+	return t.TranslateName(sc.Name)
+}
+
+// TranslateSubcall returns the translation of the sub-call call of the
+// multiplexer syscall number n (e.g. socketcall or ipc).
+func (t *Translator) TranslateSubcall(n int, call int) (Translation, error) {
+	sc, err := t.from.SyscallN(n)
+	if err != nil {
+		return Translation{}, err
+	}
+	calls, ok := multiplexers[sc.Name]
+	if !ok {
+		return Translation{}, &NotMultiplexedError{Syscall: sc.Name}
+	}
+	subsc, ok := calls[call]
+	if !ok {
+		return Translation{}, &UnknownSubcallError{Syscall: sc.Name, Call: call}
+	}
+	return t.TranslateName(subsc.Name)
+}
+
+// TranslateName returns the translation of the syscall with the provided
+// name.
+func (t *Translator) TranslateName(name string) (Translation, error) {
+	// If the source table does not include the 32-bit variant, its
+	// syscall is not the legacy one. So, the 32-bit variant is preferred.
+	for _, rn := range renames {
+		if rn[1] != name || t.from.has(rn[0]) {
+			continue
+		}
+		if sc, err := t.to.implemented(rn[0]); err == nil {
+			return Translation{Syscall: sc, Call: -1}, nil
+		}
+	}
+
+	if sc, err := t.to.implemented(name); err == nil {
+		return Translation{Syscall: sc, Call: -1}, nil
+	}
+
+	for _, rn := range renames {
+		if rn[0] != name {
+			continue
+		}
+		if sc, err := t.to.implemented(rn[1]); err == nil {
+			return Translation{Syscall: sc, Call: -1}, nil
+		}
+	}
+
+	if mux, call, ok := subcall(name); ok {
+		if sc, err := t.to.implemented(mux); err == nil {
+			return Translation{Syscall: sc, Call: call}, nil
+		}
+	}
+
+	return Translation{}, &NoEquivalentError{Name: name}
+}
+
+// Unmapped returns the implemented syscalls of the source table that have no
+// equivalent in the target table, sorted by number. Multiplexers are reported
+// if any of their sub-calls has no equivalent.
+func (t *Translator) Unmapped() []Syscall {
+	var scs []Syscall
+	for n, sc := range t.from.tbl {
+		if sc.Status == SyscallNotImplemented {
+			continue
+		}
+		calls, ok := multiplexers[sc.Name]
+		if !ok {
+			if _, err := t.Translate(n); err != nil {
+				scs = append(scs, sc)
+			}
+			continue
+		}
+		for call := range calls {
+			if _, err := t.TranslateSubcall(n, call); err != nil {
+				scs = append(scs, sc)
+				break
+			}
+		}
+	}
+	sort.Sort(byNum(scs))
+	return scs
+}
+
+// has reports whether the resolver's table contains a syscall with the
+// provided name.
+func (r Resolver) has(name string) bool {
+	_, err := r.SyscallName(name)
+	return err == nil
+}
+
+// implemented returns the syscall with the provided name if it is
+// implemented.
+func (r Resolver) implemented(name string) (Syscall, error) {
+	sc, err := r.SyscallName(name)
+	if err != nil {
+		return Syscall{}, err
+	}
+	if sc.Status == SyscallNotImplemented {
+		return Syscall{}, &NotImplementedError{Num: sc.Num, Name: name}
+	}
+	return sc, nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jroimartin/syscallinfo"
+	"github.com/jroimartin/syscallinfo/linux_386"
+	"github.com/jroimartin/syscallinfo/linux_amd64"
+)
+
+var checksTranslate = []struct {
+	from     string
+	to       string
+	num      int
+	call     int
+	wantName string
+	wantCall int
+	nilError bool
+}{
+	{"linux_386", "linux_amd64", 3, -1, "read", -1, true},
+	{"linux_amd64", "linux_386", 0, -1, "read", -1, true},
+	{"linux_386", "linux_amd64", 140, -1, "lseek", -1, true},
+	{"linux_386", "linux_amd64", 192, -1, "mmap", -1, true},
+	{"linux_amd64", "linux_386", 9, -1, "mmap2", -1, true},
+	{"linux_386", "linux_386", 90, -1, "mmap", -1, true},
+	{"linux_amd64", "linux_386", 262, -1, "fstatat64", -1, true},
+	{"linux_386", "linux_amd64", 300, -1, "newfstatat", -1, true},
+	{"linux_amd64", "linux_386", 4, -1, "stat64", -1, true},
+	{"linux_amd64", "linux_386", 42, -1, "connect", -1, true},
+	{"linux_amd64", "linux_386", 64, -1, "semget", -1, true},
+	{"linux_amd64", "linux_386", 228, -1, "clock_gettime64", -1, true},
+	{"linux_386", "linux_amd64", 403, -1, "clock_gettime", -1, true},
+	{"linux_386", "linux_amd64", 265, -1, "clock_gettime", -1, true},
+	{"linux_386", "linux_amd64", 102, 3, "connect", -1, true},
+	{"linux_386", "linux_amd64", 102, 9, "sendto", -1, true},
+	{"linux_386", "linux_amd64", 117, 21, "shmat", -1, true},
+	{"linux_386", "linux_amd64", 102, -1, "", -1, false},
+	{"linux_386", "linux_amd64", 3, 1, "", -1, false},
+	{"linux_386", "linux_amd64", 113, -1, "", -1, false},
+	{"linux_amd64", "linux_386", 453, -1, "", -1, false},
+	{"linux_386", "linux_amd64", 243, -1, "", -1, false},
+}
+
+func TestTranslator_Translate(t *testing.T) {
+	for _, check := range checksTranslate {
+		tr, err := syscallinfo.NewArchTranslator(check.from, check.to)
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		var trn syscallinfo.Translation
+		if check.call < 0 {
+			trn, err = tr.Translate(check.num)
+		} else {
+			trn, err = tr.TranslateSubcall(check.num, check.call)
+		}
+		if err != nil {
+			if check.nilError {
+				t.Errorf("wrong error (want=nil, get=%v)", err)
+			}
+			continue
+		}
+		if !check.nilError {
+			t.Errorf("wrong error (want=non-nil, get=nil) for %v/%v", check.num, check.call)
+			continue
+		}
+		if trn.Syscall.Name != check.wantName {
+			t.Errorf("wrong name (want=%v, get=%v)", check.wantName, trn.Syscall.Name)
+		}
+		if trn.Call != check.wantCall {
+			t.Errorf("wrong call (want=%v, get=%v)", check.wantCall, trn.Call)
+		}
+	}
+}
+
+func TestTranslator_Translate_multiplexed(t *testing.T) {
+	// Before 4.3, the socket and ipc calls of 386 were only reachable
+	// through their multiplexers.
+	tr := syscallinfo.NewTranslator(linux_amd64.SyscallTable, linux_386.SyscallTable4_0)
+	checks := []struct {
+		num      int
+		wantName string
+		wantCall int
+	}{
+		{42, "socketcall", 3},
+		{65, "ipc", 1},
+	}
+	for _, check := range checks {
+		trn, err := tr.Translate(check.num)
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		if trn.Syscall.Name != check.wantName {
+			t.Errorf("wrong name (want=%v, get=%v)", check.wantName, trn.Syscall.Name)
+		}
+		if trn.Call != check.wantCall {
+			t.Errorf("wrong call (want=%v, get=%v)", check.wantCall, trn.Call)
+		}
+	}
+}
+
+func TestTranslator_Unmapped(t *testing.T) {
+	tr, err := syscallinfo.NewArchTranslator("linux_amd64", "linux_386")
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	names := map[string]bool{}
+	for _, sc := range tr.Unmapped() {
+		names[sc.Name] = true
+	}
+	if !names["map_shadow_stack"] {
+		t.Errorf("map_shadow_stack should not have equivalent")
+	}
+	if names["read"] || names["connect"] {
+		t.Errorf("read and connect should have equivalent")
+	}
+}
+
+func TestArches(t *testing.T) {
+	arches := syscallinfo.Arches()
+	want := []string{"linux_386", "linux_amd64", "linux_arm", "linux_arm64", "linux_riscv64"}
+	if !reflect.DeepEqual(arches, want) {
+		t.Errorf("wrong arches (want=%v, get=%v)", want, arches)
+	}
+	if _, err := syscallinfo.Table("linux_foo"); err == nil {
+		t.Errorf("wrong error (want=non-nil, get=nil)")
+	}
+}
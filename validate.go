@@ -0,0 +1,243 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxArgs is the maximum number of arguments a syscall can take.
+const MaxArgs = 6
+
+// A Problem identifies the kind of inconsistency found by Validate.
+type Problem int
+
+const (
+	// ProbNumMismatch means that the syscall number does not match its key
+	// in the table.
+	ProbNumMismatch Problem = iota
+	// ProbMissingName means that the syscall has no name.
+	ProbMissingName
+	// ProbMissingEntry means that the syscall has no entry point.
+	ProbMissingEntry
+	// ProbDuplicateName means that several syscalls share the same name.
+	// The compat entries and the x32 entries of linux_amd64 are not
+	// considered duplicates.
+	ProbDuplicateName
+	// ProbTooManyArgs means that the syscall takes more than MaxArgs
+	// arguments.
+	ProbTooManyArgs
+	// ProbMissingSig means that an argument has no signature.
+	ProbMissingSig
+	// ProbRefCount means that the RefCount of an argument does not match
+	// the indirection level of its signature.
+	ProbRefCount
+	// ProbPointerContext means that an argument passed by reference has a
+	// context, although contexts only apply to values.
+	ProbPointerContext
+	// ProbDuplicateNum means that several syscalls share the same number.
+	// It is only reported when loading a table, since the keys of a
+	// SyscallTable are unique.
+	ProbDuplicateNum
+	// ProbMissingNum means that a number below the maximum of the table
+	// has no syscall, although the kernel assigns it in the arch.
+	ProbMissingNum
+)
+
+var problemNames = []string{
+	ProbNumMismatch:    "number mismatch",
+	ProbMissingName:    "missing name",
+	ProbMissingEntry:   "missing entry point",
+	ProbDuplicateName:  "duplicate name",
+	ProbTooManyArgs:    "too many arguments",
+	ProbMissingSig:     "missing argument signature",
+	ProbRefCount:       "refcount mismatch",
+	ProbPointerContext: "context on pointer argument",
+	ProbDuplicateNum:   "duplicate number",
+	ProbMissingNum:     "missing number",
+}
+
+// String returns the description of p.
+func (p Problem) String() string {
+	if p < 0 || int(p) >= len(problemNames) {
+		return fmt.Sprintf("problem %d", int(p))
+	}
+	return problemNames[p]
+}
+
+// A ValidationError describes a problem found in a syscall table.
+type ValidationError struct {
+	// Num is the key of the offending syscall in the table.
+	Num int
+
+	// Name is the name of the offending syscall.
+	Name string
+
+	// Arg is the index of the offending argument, or -1 if the problem is
+	// not related to a specific argument.
+	Arg int
+
+	// Problem is the kind of problem.
+	Problem Problem
+}
+
+func (e *ValidationError) Error() string {
+	if e.Arg >= 0 {
+		return fmt.Sprintf("syscall %d (%s): argument %d: %v", e.Num, e.Name, e.Arg, e.Problem)
+	}
+	return fmt.Sprintf("syscall %d (%s): %v", e.Num, e.Name, e.Problem)
+}
+
+// ValidationErrors contains all the problems found in a syscall table.
+type ValidationErrors []*ValidationError
+
+func (errs ValidationErrors) Error() string {
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Validate checks the consistency of tbl, which is the syscall table of the
+// provided arch (e.g. "linux_amd64"). The numbers that the kernel does not
+// assign in a known arch are not reported as missing; for other archs,
+// every number below the maximum of the table must have a syscall. If
+// problems are found, it returns all of them as ValidationErrors sorted by
+// syscall number. Otherwise, it returns nil.
+func Validate(arch string, tbl SyscallTable) error {
+	var errs ValidationErrors
+	layout := archLayouts[arch]
+	nums := tableNums(tbl)
+	maxNative := -1
+	for _, n := range nums {
+		if layout.x32First == 0 || n < layout.x32First {
+			maxNative = n
+		}
+	}
+	names := map[string][]Syscall{}
+	next := 0
+	for _, n := range nums {
+		for ; next < n; next++ {
+			if !layout.assigned(next, maxNative) {
+				continue
+			}
+			errs = append(errs, &ValidationError{Num: next, Arg: -1, Problem: ProbMissingNum})
+		}
+		next = n + 1
+
+		sc := tbl[n]
+		report := func(arg int, p Problem) {
+			errs = append(errs, &ValidationError{Num: n, Name: sc.Name, Arg: arg, Problem: p})
+		}
+		if sc.Num != n {
+			report(-1, ProbNumMismatch)
+		}
+		if sc.Name == "" {
+			report(-1, ProbMissingName)
+		} else {
+			names[sc.Name] = append(names[sc.Name], sc)
+		}
+		if sc.Entry == "" {
+			report(-1, ProbMissingEntry)
+		}
+		if len(sc.Args) > MaxArgs {
+			report(-1, ProbTooManyArgs)
+		}
+		for i, arg := range sc.Args {
+			if arg.Sig == "" {
+				report(i, ProbMissingSig)
+			} else if arg.RefCount != strings.Count(arg.Sig, "*") {
+				report(i, ProbRefCount)
+			}
+			if arg.Context != CtxNone && arg.RefCount > 0 {
+				report(i, ProbPointerContext)
+			}
+		}
+		if scs := names[sc.Name]; len(scs) > 1 && !layout.compatDuplicates(scs) {
+			report(-1, ProbDuplicateName)
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// A numRange is an inclusive range of syscall numbers.
+type numRange struct {
+	first, last int
+}
+
+// An archLayout describes the numbering of the syscall table of an arch.
+type archLayout struct {
+	// unassigned contains the numbers below the maximum of the arch that
+	// are not listed in the syscall table of the kernel.
+	unassigned []numRange
+
+	// x32First is the first number of the x32 syscalls, or 0 if the arch
+	// has none. The numbers between the last native syscall and x32First
+	// are not assigned. Since Linux 5.10, some x32 syscalls use the native
+	// entry points, so they are not considered duplicates.
+	x32First int
+}
+
+// archLayouts contains the layouts of the known archs. The OABI-only numbers
+// of linux_arm (e.g. 102 socketcall) are not part of the EABI table.
+var archLayouts = map[string]archLayout{
+	"linux_386": {
+		unassigned: []numRange{{222, 223}, {251, 251}, {285, 285}, {387, 392}, {415, 415}, {453, 453}},
+	},
+	"linux_amd64": {
+		unassigned: []numRange{{336, 423}},
+		x32First:   512,
+	},
+	"linux_arm": {
+		unassigned: []numRange{
+			{7, 7}, {13, 13}, {17, 18}, {22, 22}, {25, 25}, {27, 28}, {30, 32},
+			{35, 35}, {44, 44}, {48, 48}, {53, 53}, {56, 56}, {58, 59}, {68, 69},
+			{76, 76}, {82, 82}, {84, 84}, {89, 90}, {98, 98}, {101, 102},
+			{109, 110}, {112, 113}, {117, 117}, {123, 123}, {127, 127},
+			{130, 130}, {137, 137}, {166, 167}, {188, 189}, {222, 223},
+			{254, 255}, {402, 402}, {415, 415}, {447, 447},
+		},
+	},
+	"linux_arm64": {
+		unassigned: []numRange{{244, 259}, {295, 423}},
+	},
+	"linux_riscv64": {
+		unassigned: []numRange{{38, 38}, {244, 257}, {295, 423}},
+	},
+}
+
+// assigned reports whether the kernel assigns the number n in the arch.
+// maxNative is the greatest native syscall number of the table.
+func (l archLayout) assigned(n, maxNative int) bool {
+	if l.x32First != 0 && n > maxNative && n < l.x32First {
+		return false
+	}
+	for _, r := range l.unassigned {
+		if n >= r.first && n <= r.last {
+			return false
+		}
+	}
+	return true
+}
+
+// compatDuplicates reports whether all the syscalls in scs but the first one
+// are compat entries or x32 entries.
+func (l archLayout) compatDuplicates(scs []Syscall) bool {
+	for _, sc := range scs[1:] {
+		if strings.HasPrefix(sc.Entry, "compat_") {
+			continue
+		}
+		if l.x32First != 0 && sc.Num >= l.x32First {
+			continue
+		}
+		return false
+	}
+	return true
+}
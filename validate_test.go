@@ -0,0 +1,159 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo_test
+
+import (
+	"testing"
+
+	"github.com/jroimartin/syscallinfo"
+	_ "github.com/jroimartin/syscallinfo/linux_386"
+	_ "github.com/jroimartin/syscallinfo/linux_amd64"
+	"github.com/jroimartin/syscallinfo/linux_arm64"
+)
+
+func TestValidate_shipped(t *testing.T) {
+	for _, arch := range syscallinfo.Arches() {
+		tbl, err := syscallinfo.Table(arch)
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		if err := syscallinfo.Validate(arch, tbl); err != nil {
+			t.Errorf("invalid table %v: %v", arch, err)
+		}
+		for _, v := range syscallinfo.Snapshots(arch) {
+			tbl, err := syscallinfo.Snapshot(arch, v)
+			if err != nil {
+				t.Fatalf("wrong error (want=nil, get=%v)", err)
+			}
+			if err := syscallinfo.Validate(arch, tbl); err != nil {
+				t.Errorf("invalid snapshot %v %v: %v", arch, v, err)
+			}
+		}
+	}
+}
+
+var invalidTable = syscallinfo.SyscallTable{
+	0: {Num: 1, Name: "read", Entry: "sys_read"},
+	1: {Num: 1, Name: "", Entry: "sys_write"},
+	2: {Num: 2, Name: "open"},
+	3: {Num: 3, Name: "read", Entry: "sys_read"},
+	4: {Num: 4, Name: "foo", Entry: "sys_foo", Args: make([]syscallinfo.Argument, 7)},
+	5: {Num: 5, Name: "bar", Entry: "sys_bar", Args: []syscallinfo.Argument{
+		{RefCount: 0, Sig: "char __user *buf"},
+		{RefCount: 1, Sig: "int __user *fd", Context: syscallinfo.CtxFD},
+	}},
+	6: {Num: 6, Name: "read", Entry: "compat_sys_read"},
+}
+
+var wantValidation = []struct {
+	num     int
+	arg     int
+	problem syscallinfo.Problem
+}{
+	{0, -1, syscallinfo.ProbNumMismatch},
+	{1, -1, syscallinfo.ProbMissingName},
+	{2, -1, syscallinfo.ProbMissingEntry},
+	{3, -1, syscallinfo.ProbDuplicateName},
+	{4, -1, syscallinfo.ProbTooManyArgs},
+	{4, 0, syscallinfo.ProbMissingSig},
+	{4, 1, syscallinfo.ProbMissingSig},
+	{4, 2, syscallinfo.ProbMissingSig},
+	{4, 3, syscallinfo.ProbMissingSig},
+	{4, 4, syscallinfo.ProbMissingSig},
+	{4, 5, syscallinfo.ProbMissingSig},
+	{4, 6, syscallinfo.ProbMissingSig},
+	{5, 0, syscallinfo.ProbRefCount},
+	{5, 1, syscallinfo.ProbPointerContext},
+	{6, -1, syscallinfo.ProbDuplicateName},
+}
+
+func TestValidate(t *testing.T) {
+	err := syscallinfo.Validate("", invalidTable)
+	errs, ok := err.(syscallinfo.ValidationErrors)
+	if !ok {
+		t.Fatalf("wrong error type (want=ValidationErrors, get=%T)", err)
+	}
+	if len(errs) != len(wantValidation) {
+		t.Fatalf("wrong number of errors (want=%v, get=%v): %v", len(wantValidation), len(errs), err)
+	}
+	for i, want := range wantValidation {
+		get := errs[i]
+		if get.Num != want.num || get.Arg != want.arg || get.Problem != want.problem {
+			t.Errorf("wrong error (want=%v/%v/%v, get=%v/%v/%v)",
+				want.num, want.arg, want.problem, get.Num, get.Arg, get.Problem)
+		}
+	}
+}
+
+var checksValidateArch = []struct {
+	arch    string
+	tbl     syscallinfo.SyscallTable
+	num     int
+	problem syscallinfo.Problem
+	ok      bool
+}{
+	{
+		"linux_amd64",
+		syscallinfo.SyscallTable{
+			0:   {Num: 0, Name: "read", Entry: "sys_read"},
+			512: {Num: 512, Name: "read", Entry: "sys_read"},
+		},
+		0, 0, true,
+	},
+	{
+		"linux_386",
+		syscallinfo.SyscallTable{
+			0:   {Num: 0, Name: "read", Entry: "sys_read"},
+			1:   {Num: 1, Name: "write", Entry: "sys_write"},
+			2:   {Num: 2, Name: "read", Entry: "sys_read"},
+			512: {Num: 512, Name: "write", Entry: "sys_write"},
+		},
+		2, syscallinfo.ProbDuplicateName, false,
+	},
+	{
+		"linux_arm64",
+		withoutNum(linux_arm64.SyscallTable, 63),
+		63, syscallinfo.ProbMissingNum, false,
+	},
+	{
+		"",
+		syscallinfo.SyscallTable{
+			0: {Num: 0, Name: "read", Entry: "sys_read"},
+			2: {Num: 2, Name: "open", Entry: "sys_open"},
+		},
+		1, syscallinfo.ProbMissingNum, false,
+	},
+}
+
+// withoutNum returns a copy of tbl without the syscall n.
+func withoutNum(tbl syscallinfo.SyscallTable, n int) syscallinfo.SyscallTable {
+	t := syscallinfo.SyscallTable{}
+	for k, sc := range tbl {
+		if k != n {
+			t[k] = sc
+		}
+	}
+	return t
+}
+
+func TestValidate_arch(t *testing.T) {
+	for _, check := range checksValidateArch {
+		err := syscallinfo.Validate(check.arch, check.tbl)
+		if check.ok {
+			if err != nil {
+				t.Errorf("%v: wrong error (want=nil, get=%v)", check.arch, err)
+			}
+			continue
+		}
+		errs, ok := err.(syscallinfo.ValidationErrors)
+		if !ok {
+			t.Errorf("%v: wrong error type (want=ValidationErrors, get=%T)", check.arch, err)
+			continue
+		}
+		if get := errs[0]; get.Num != check.num || get.Problem != check.problem {
+			t.Errorf("%v: wrong error (want=%v/%v, get=%v/%v)", check.arch, check.num, check.problem, get.Num, get.Problem)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A KernelVersion identifies a Linux kernel release. The zero value
+// represents an unknown version.
+type KernelVersion struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// ParseKernelVersion parses a kernel version with the form "major.minor" or
+// "major.minor.patch" (e.g. "4.19" or "2.6.16"). Any suffix starting with "-"
+// or "+" is ignored.
+func ParseKernelVersion(release string) (KernelVersion, error) {
+	s := release
+	if i := strings.IndexAny(s, "-+"); i >= 0 {
+		s = s[:i]
+	}
+	fields := strings.Split(s, ".")
+	if len(fields) < 2 || len(fields) > 3 {
+		return KernelVersion{}, &ParseError{Kind: "kernel version", Value: release}
+	}
+	var nums [3]int
+	for i, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil || n < 0 {
+			return KernelVersion{}, &ParseError{Kind: "kernel version", Value: release}
+		}
+		nums[i] = n
+	}
+	return KernelVersion{Major: nums[0], Minor: nums[1], Patch: nums[2]}, nil
+}
+
+// String returns the string representation of v.
+func (v KernelVersion) String() string {
+	if v.Patch != 0 {
+		return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	}
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// IsZero reports whether v is the zero value.
+func (v KernelVersion) IsZero() bool {
+	return v == KernelVersion{}
+}
+
+// Less reports whether v is older than w.
+func (v KernelVersion) Less(w KernelVersion) bool {
+	if v.Major != w.Major {
+		return v.Major < w.Major
+	}
+	if v.Minor != w.Minor {
+		return v.Minor < w.Minor
+	}
+	return v.Patch < w.Patch
+}
+
+// AvailableOn reports whether sc is available on the kernel version v. A
+// syscall with a zero Since predates the earliest tracked version (2.6.0), so
+// it is considered available on any version. Reserved numbers that were never
+// implemented (status SyscallNotImplemented and zero Until) are not
+// available on any version.
+func (sc Syscall) AvailableOn(v KernelVersion) bool {
+	if sc.Status == SyscallNotImplemented && sc.Until.IsZero() {
+		return false
+	}
+	if v.Less(sc.Since) {
+		return false
+	}
+	if !sc.Until.IsZero() && !v.Less(sc.Until) {
+		return false
+	}
+	return true
+}
+
+// AvailableOn returns the syscalls available on the kernel version v, sorted
+// by number.
+func (r Resolver) AvailableOn(v KernelVersion) []Syscall {
+	var scs []Syscall
+	for _, sc := range r.tbl {
+		if sc.AvailableOn(v) {
+			scs = append(scs, sc)
+		}
+	}
+	sort.Sort(byNum(scs))
+	return scs
+}
+
+// A VersionAnnotation contains the kernel versions in which syscalls were
+// added and removed. It is the format of the annotation file used by
+// mksyscalltable.go. Syscalls are identified by name, except in the
+// per-arch overrides, where they are identified by number.
+type VersionAnnotation struct {
+	Since map[string]string                `json:"since"`
+	Until map[string]string                `json:"until"`
+	Arch  map[string]ArchVersionAnnotation `json:"arch"`
+}
+
+// An ArchVersionAnnotation contains the kernel versions of the syscalls of a
+// specific arch that differ from the common ones. Base is the release that
+// added the arch (e.g. "3.7" for linux_arm64); no syscall of the arch is
+// older than it.
+type ArchVersionAnnotation struct {
+	Base  string            `json:"base"`
+	Since map[string]string `json:"since"`
+	Until map[string]string `json:"until"`
+}
+
+// ParseVersionAnnotation parses a JSON version annotation file.
+func ParseVersionAnnotation(data []byte) (*VersionAnnotation, error) {
+	ann := &VersionAnnotation{}
+	if err := json.Unmarshal(data, ann); err != nil {
+		return nil, err
+	}
+	return ann, nil
+}
+
+// Versions returns the kernel versions in which the syscall sc of the
+// provided arch was added and removed.
+func (ann *VersionAnnotation) Versions(arch string, sc Syscall) (since, until KernelVersion, err error) {
+	num := strconv.Itoa(sc.Num)
+	since, err = annotatedVersion(ann.Since[sc.Name], ann.Arch[arch].Since[num])
+	if err != nil {
+		return KernelVersion{}, KernelVersion{}, err
+	}
+	base, err := annotatedVersion(ann.Arch[arch].Base, "")
+	if err != nil {
+		return KernelVersion{}, KernelVersion{}, err
+	}
+	if since.Less(base) {
+		since = base
+	}
+	until, err = annotatedVersion(ann.Until[sc.Name], ann.Arch[arch].Until[num])
+	if err != nil {
+		return KernelVersion{}, KernelVersion{}, err
+	}
+	return since, until, nil
+}
+
+// annotatedVersion parses the arch-specific version if it is not empty.
+// Otherwise, it parses the common one. If both are empty, it returns the zero
+// version.
+func annotatedVersion(common, arch string) (KernelVersion, error) {
+	s := common
+	if arch != "" {
+		s = arch
+	}
+	if s == "" {
+		return KernelVersion{}, nil
+	}
+	return ParseKernelVersion(s)
+}
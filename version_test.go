@@ -0,0 +1,165 @@
+// Copyright 2015 The syscallinfo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscallinfo_test
+
+import (
+	"testing"
+
+	"github.com/jroimartin/syscallinfo"
+	"github.com/jroimartin/syscallinfo/linux_386"
+	"github.com/jroimartin/syscallinfo/linux_amd64"
+	"github.com/jroimartin/syscallinfo/linux_arm64"
+	"github.com/jroimartin/syscallinfo/linux_riscv64"
+)
+
+var checksKernelVersion = []struct {
+	s   string
+	v   syscallinfo.KernelVersion
+	str string
+	err bool
+}{
+	{"4.19", syscallinfo.KernelVersion{Major: 4, Minor: 19}, "4.19", false},
+	{"2.6.16", syscallinfo.KernelVersion{Major: 2, Minor: 6, Patch: 16}, "2.6.16", false},
+	{"5.10.0-23-amd64", syscallinfo.KernelVersion{Major: 5, Minor: 10}, "5.10", false},
+	{"4", syscallinfo.KernelVersion{}, "", true},
+	{"4.x", syscallinfo.KernelVersion{}, "", true},
+	{"1.2.3.4", syscallinfo.KernelVersion{}, "", true},
+}
+
+func TestParseKernelVersion(t *testing.T) {
+	for _, check := range checksKernelVersion {
+		v, err := syscallinfo.ParseKernelVersion(check.s)
+		if (err != nil) != check.err {
+			t.Errorf("wrong error for %q (want=%v, get=%v)", check.s, check.err, err)
+			continue
+		}
+		if v != check.v {
+			t.Errorf("wrong version (want=%v, get=%v)", check.v, v)
+		}
+		if !check.err && v.String() != check.str {
+			t.Errorf("wrong string (want=%v, get=%v)", check.str, v.String())
+		}
+	}
+}
+
+var checksAvailableOn = []struct {
+	tbl       syscallinfo.SyscallTable
+	version   string
+	available []string
+	missing   []string
+}{
+	{
+		linux_amd64.SyscallTable,
+		"2.6.15",
+		[]string{"read", "_sysctl", "inotify_init"},
+		[]string{"openat", "getrandom"},
+	},
+	{
+		linux_amd64.SyscallTable,
+		"3.17",
+		[]string{"openat", "getrandom", "memfd_create", "_sysctl"},
+		[]string{"bpf", "execveat"},
+	},
+	{
+		linux_amd64.SyscallTable,
+		"2.6.15",
+		[]string{"nfsservctl"},
+		[]string{"create_module", "get_kernel_syms", "query_module", "tuxcall"},
+	},
+	{
+		linux_amd64.SyscallTable,
+		"4.19",
+		[]string{"read", "_sysctl", "lookup_dcookie"},
+		[]string{
+			"create_module", "get_kernel_syms", "query_module", "nfsservctl",
+			"getpmsg", "putpmsg", "afs_syscall", "tuxcall", "security",
+			"epoll_ctl_old", "epoll_wait_old", "vserver", "uselib",
+		},
+	},
+	{
+		linux_386.SyscallTable,
+		"4.19",
+		[]string{"socketcall", "_sysctl"},
+		[]string{"break", "stty", "gtty", "idle", "create_module", "nfsservctl", "vserver"},
+	},
+	{
+		linux_386.SyscallTable,
+		"5.5",
+		[]string{"fstatat64", "execveat", "socketcall"},
+		[]string{"_sysctl"},
+	},
+}
+
+func TestResolver_AvailableOn(t *testing.T) {
+	for _, check := range checksAvailableOn {
+		v, err := syscallinfo.ParseKernelVersion(check.version)
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		r := syscallinfo.NewResolver(check.tbl)
+		names := map[string]bool{}
+		for _, sc := range r.AvailableOn(v) {
+			names[sc.Name] = true
+		}
+		for _, name := range check.available {
+			if !names[name] {
+				t.Errorf("%v should be available on %v", name, v)
+			}
+		}
+		for _, name := range check.missing {
+			if names[name] {
+				t.Errorf("%v should not be available on %v", name, v)
+			}
+		}
+	}
+}
+
+var checksAvailableOnPort = []struct {
+	tbl     syscallinfo.SyscallTable
+	before  string
+	version string
+	names   []string
+}{
+	{linux_arm64.SyscallTable, "3.6", "4.19", []string{"read", "openat", "lookup_dcookie"}},
+	{linux_riscv64.SyscallTable, "4.14", "4.19", []string{"read", "openat", "riscv_flush_icache"}},
+}
+
+func TestResolver_AvailableOn_port(t *testing.T) {
+	for _, check := range checksAvailableOnPort {
+		before, err := syscallinfo.ParseKernelVersion(check.before)
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		v, err := syscallinfo.ParseKernelVersion(check.version)
+		if err != nil {
+			t.Fatalf("wrong error (want=nil, get=%v)", err)
+		}
+		r := syscallinfo.NewResolver(check.tbl)
+		if scs := r.AvailableOn(before); len(scs) != 0 {
+			t.Errorf("wrong number of syscalls on %v (want=0, get=%v)", before, len(scs))
+		}
+		names := map[string]bool{}
+		for _, sc := range r.AvailableOn(v) {
+			names[sc.Name] = true
+		}
+		for _, name := range check.names {
+			if !names[name] {
+				t.Errorf("%v should be available on %v", name, v)
+			}
+		}
+	}
+}
+
+func TestSyscall_AvailableOn_x32(t *testing.T) {
+	r := syscallinfo.NewResolver(linux_amd64.SyscallTable)
+	sc, err := r.SyscallN(534)
+	if err != nil {
+		t.Fatalf("wrong error (want=nil, get=%v)", err)
+	}
+	want := syscallinfo.KernelVersion{Major: 3, Minor: 4}
+	if sc.Since != want {
+		t.Errorf("wrong since (want=%v, get=%v)", want, sc.Since)
+	}
+}